@@ -41,7 +41,13 @@ var root = &cobra.Command{
 func init() {
 	cobra.OnInitialize(onInit)
 	root.AddCommand(changelogCmd)
+	root.AddCommand(checksumCmd)
 	root.AddCommand(configCmd)
+	root.AddCommand(diffCmd)
+	root.AddCommand(lintCmd)
+	root.AddCommand(lintSchemaCmd)
+	root.AddCommand(snapshotCmd)
+	root.AddCommand(templateCmd)
 	root.AddCommand(usageCmd)
 	root.AddCommand(versionCmd)
 }
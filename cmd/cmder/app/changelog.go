@@ -19,8 +19,12 @@ package app
 import (
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/chzyer/readline"
+	"github.com/photowey/liquigen/configs"
 	"github.com/photowey/liquigen/internal/cmd/changelog"
+	"github.com/photowey/liquigen/pkg/filez"
 	"github.com/photowey/liquigen/pkg/stringz"
 	"github.com/spf13/cobra"
 )
@@ -37,8 +41,40 @@ var (
 	dialect  string
 	database string
 	format   string
+	params   map[string]string
 
-	sqlFile string
+	concurrency int
+	timeout     time.Duration
+
+	conflict     string
+	force        bool
+	skipExisting bool
+	dryRun       bool
+	stdout       bool
+	output       string
+	scaffold     string
+	date         string
+
+	preconditions bool
+	targets       []string
+	quoting       string
+	baseline      string
+
+	templatesDir      string
+	build             string
+	groupId           string
+	artifactId        string
+	artifactVersion   string
+	javaPackage       string
+	serverPort        int
+	javaVersion       string
+	springBootVersion string
+	liquibaseVersion  string
+	jdbcDrivers       map[string]string
+
+	sqlFile       string
+	snapshotFile  string
+	liquibaseFile string
 
 	changelogCmd = &cobra.Command{
 		Use:     "changelog",
@@ -56,6 +92,12 @@ var (
 				return
 			}
 
+			if stringz.IsNotBlankString(snapshotFile) {
+				changelog.OnSnapshotMode(argz)
+
+				return
+			}
+
 			changelog.OnDatabaseMode(argz)
 		},
 	}
@@ -67,27 +109,218 @@ func populateArgs() (*changelog.Args, error) {
 		return nil, fmt.Errorf("get current working directory failed: %v", err)
 	}
 
-	return &changelog.Args{
+	path, err := populateOutputPath(cwd)
+	if err != nil {
+		return nil, err
+	}
+	layout, err := changelog.ConfigLayout()
+	if err != nil {
+		return nil, err
+	}
+
+	changeSet, err := changelog.ConfigChangeSetPolicy()
+	if err != nil {
+		return nil, err
+	}
+	changeSet.Preconditions = changeSet.Preconditions || preconditions
+
+	identifiers, err := changelog.ConfigIdentifierPolicy(targets, quoting)
+	if err != nil {
+		return nil, err
+	}
+
+	emailz := populateEmail()
+	if changeSet.RequiresEmail() && stringz.IsBlankString(emailz) {
+		return nil, fmt.Errorf("the changeSet author pattern %s needs an email, use --email or the project.email of liquigen.json",
+			changeSet.Author)
+	}
+
+	scaffoldz, err := populateScaffold()
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := populateProject()
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := changelog.ResolveTemplatesDir(templatesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	baselinez, err := changelog.ParseBaseline(baseline)
+	if err != nil {
+		return nil, err
+	}
+
+	datez, err := changelog.ResolveDate(date)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := populateConflictMode()
+	if err != nil {
+		return nil, err
+	}
+	if dryRun && stdout {
+		return nil, fmt.Errorf("--dry-run and --stdout are mutually exclusive")
+	}
+
+	argz := &changelog.Args{
 		Author:   author,
-		Email:    email,
+		Email:    emailz,
 		Version:  changeSetVersion,
 		Cwd:      cwd,
-		Path:     cwd,
+		Path:     path,
 		Host:     host,
 		Port:     port,
 		Username: username,
 		Password: password,
 		Dialect:  dialect,
 		Database: database,
+		Params:   params,
 		Format:   format,
-		SQLFile:  sqlFile,
-	}, nil
+		Date:     datez,
+		Scaffold: scaffoldz,
+		Baseline: baselinez,
+		Project:  project,
+
+		Templates: templates,
+		Conflict:  mode,
+		SQLFile:   sqlFile,
+		Snapshot:  snapshotFile,
+
+		Liquibase: liquibaseFile,
+
+		Concurrency: concurrency,
+		Timeout:     timeout,
+
+		ChangeSet:   changeSet,
+		Identifiers: identifiers,
+		Layout:      layout,
+		DryRun:      dryRun,
+	}
+
+	if stdout {
+		// Only the changelog documents go to stdout, the reports and prompts to stderr.
+		argz.Stdout = os.Stdout
+		os.Stdout = os.Stderr
+		readline.Stdout = os.Stderr
+	}
+
+	return argz, nil
+}
+
+// populateEmail resolves --email, then the project.email of liquigen.json.
+func populateEmail() string {
+	if stringz.IsNotBlankString(email) {
+		return email
+	}
+
+	return configs.ConfigProject().Email
+}
+
+// populateOutputPath resolves the output directory: --output, then the output.dir of
+// liquigen.json, then the current working directory.
+func populateOutputPath(cwd string) (string, error) {
+	path := output
+	if stringz.IsBlankString(path) {
+		path = configs.ConfigOutput().Dir
+	}
+	if stringz.IsBlankString(path) {
+		return cwd, nil
+	}
+
+	return filez.Clean(path)
+}
+
+// populateProject resolves the scaffold settings: the flags, then the project section of
+// liquigen.json, then the defaults.
+func populateProject() (*changelog.Project, error) {
+	project, err := changelog.ConfigProject()
+	if err != nil {
+		return nil, err
+	}
+
+	buildz, err := changelog.ParseBuild(build)
+	if err != nil {
+		return nil, err
+	}
+	if stringz.IsBlankString(build) {
+		buildz = project.Build
+	}
+
+	project.Merge(&changelog.Project{
+		Build:           buildz,
+		GroupId:         groupId,
+		ArtifactId:      artifactId,
+		ArtifactVersion: artifactVersion,
+		Package:         javaPackage,
+		ServerPort:      serverPort,
+
+		JavaVersion:       javaVersion,
+		SpringBootVersion: springBootVersion,
+		LiquibaseVersion:  liquibaseVersion,
+
+		Drivers: jdbcDrivers,
+	})
+
+	if err := project.Validate(); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// populateScaffold resolves --scaffold, then the output.scaffold of liquigen.json.
+func populateScaffold() (changelog.Scaffold, error) {
+	if stringz.IsBlankString(scaffold) {
+		return changelog.ParseScaffold(configs.ConfigOutput().Scaffold)
+	}
+
+	return changelog.ParseScaffold(scaffold)
+}
+
+// populateConflictMode resolves --conflict, and its shortcuts --force and --skip-existing.
+func populateConflictMode() (changelog.ConflictMode, error) {
+	switch {
+	case force && skipExisting:
+		return "", fmt.Errorf("--force and --skip-existing are mutually exclusive")
+	case force:
+		return changelog.ConflictOverwrite, nil
+	case skipExisting:
+		return changelog.ConflictSkip, nil
+	default:
+		return changelog.ParseConflictMode(conflict)
+	}
+}
+
+func populateOutputFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&output, "output", "o", "", "Output directory(default: output.dir of liquigen.json, or $PWD)")
+	cmd.PersistentFlags().StringVar(&conflict, "conflict", string(changelog.ConflictFail),
+		"How generated files replace existing ones(fail|skip|overwrite|new)")
+	cmd.PersistentFlags().BoolVar(&force, "force", false, "Overwrite existing files, same as --conflict overwrite")
+	cmd.PersistentFlags().BoolVar(&skipExisting, "skip-existing", false, "Keep existing files, same as --conflict skip")
+	cmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "List the files to generate, with their diff, without writing them")
+	cmd.PersistentFlags().BoolVar(&stdout, "stdout", false, "Print the changelog documents to stdout instead of writing the files")
+	cmd.PersistentFlags().StringVar(&date, "date", "",
+		"Generation date of the changeSet ids(yyyy-MM-dd|yyyyMMdd, default: $SOURCE_DATE_EPOCH, or today)")
 }
 
 func init() {
 	changelogCmd.PersistentFlags().StringVarP(&author, "author", "a", "", "Author")
 	changelogCmd.PersistentFlags().StringVarP(&email, "email", "e", "", "Email")
 	changelogCmd.PersistentFlags().StringVarP(&changeSetVersion, "version", "V", "", "Change set version")
+	changelogCmd.PersistentFlags().BoolVar(&preconditions, "preconditions", false,
+		"Emit the preconditions of the changeSets, e.g. not tableExists(default: changeSet.preconditions of liquigen.json)")
+	changelogCmd.PersistentFlags().StringSliceVar(&targets, "targets", nil,
+		"Target dialects of the reserved words and identifier length, e.g. postgres,oracle(default: lint.dialects of liquigen.json, or --dialect)")
+	changelogCmd.PersistentFlags().StringVar(&quoting, "quoting", "",
+		"objectQuotingStrategy of the changeSets naming a reserved identifier(reserved|all|none, default: changeSet.quoting of liquigen.json)")
+	changelogCmd.PersistentFlags().StringVar(&baseline, "baseline", "",
+		"Also generate the DATABASECHANGELOG sync of the changeSets(sql|csv), to adopt Liquibase on an existing database")
 
 	// Database mode
 	changelogCmd.PersistentFlags().StringVarP(&host, "host", "H", "", "Target database host")
@@ -96,8 +329,34 @@ func init() {
 	changelogCmd.PersistentFlags().StringVarP(&password, "password", "p", "", "Target database authentication password")
 	changelogCmd.PersistentFlags().StringVarP(&dialect, "dialect", "D", "", "Target database dialect")
 	changelogCmd.PersistentFlags().StringVarP(&database, "database", "d", "", "Target database name")
+	changelogCmd.PersistentFlags().StringToStringVar(&params, "param", nil,
+		"Target database connection parameters, e.g. sslmode=verify-full(default: database.params of liquigen.json)")
 	changelogCmd.PersistentFlags().StringVarP(&dialect, "format", "f", "", "Target database changelog format")
+	changelogCmd.PersistentFlags().IntVarP(&concurrency, "concurrency", "c", 0, "Number of tables introspected in parallel")
+	changelogCmd.PersistentFlags().DurationVarP(&timeout, "timeout", "t", changelog.DefaultTimeout, "Timeout of database introspection")
+
+	populateOutputFlags(changelogCmd)
+	changelogCmd.PersistentFlags().StringVar(&scaffold, "scaffold", "",
+		"Generated scaffold(full|changelogs), changelogs writes the changelogs and updates the master changelog only")
+
+	// Scaffold
+	changelogCmd.PersistentFlags().StringVar(&templatesDir, "templates", "",
+		"Templates overriding the embedded ones, a directory or a template set of ~/.liquigen/templates(default: default)")
+	changelogCmd.PersistentFlags().StringVar(&build, "build", "", "Scaffold build tool(maven|gradle)")
+	changelogCmd.PersistentFlags().StringVar(&groupId, "group-id", "", "Scaffold Maven groupId")
+	changelogCmd.PersistentFlags().StringVar(&artifactId, "artifact-id", "", "Scaffold Maven artifactId")
+	changelogCmd.PersistentFlags().StringVar(&artifactVersion, "artifact-version", "", "Scaffold Maven version")
+	changelogCmd.PersistentFlags().StringVar(&javaPackage, "package", "", "Scaffold Java package")
+	changelogCmd.PersistentFlags().IntVar(&serverPort, "server-port", 0, "Scaffold server port")
+	changelogCmd.PersistentFlags().StringVar(&javaVersion, "java-version", "", "Scaffold Java version")
+	changelogCmd.PersistentFlags().StringVar(&springBootVersion, "spring-boot-version", "", "Scaffold Spring Boot version")
+	changelogCmd.PersistentFlags().StringVar(&liquibaseVersion, "liquibase-version", "", "Scaffold Liquibase version")
+	changelogCmd.PersistentFlags().StringToStringVar(&jdbcDrivers, "jdbc-driver", nil,
+		"Scaffold JDBC driver of a dialect, e.g. mysql=com.mysql:mysql-connector-j[:8.0.33]")
 
 	// SQL file mode
 	changelogCmd.PersistentFlags().StringVarP(&sqlFile, "sql", "s", "", "SQL file")
+
+	// Snapshot file mode
+	changelogCmd.PersistentFlags().StringVarP(&snapshotFile, "snapshot", "S", "", "Snapshot file(.json|.yaml|.yml)")
 }
@@ -0,0 +1,56 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package app
+
+import (
+	"github.com/photowey/liquigen/internal/cmd/changelog"
+	"github.com/photowey/liquigen/internal/cmd/database/liquibase"
+	"github.com/spf13/cobra"
+)
+
+var (
+	checksumVersion string
+	checksumVerify  string
+	checksumJSON    bool
+
+	checksumCmd = &cobra.Command{
+		Use:   "checksum <changelog>",
+		Short: "Compute the Liquibase checksums(MD5SUM) of the changeSets of a changelog",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			version, err := liquibase.ParseChecksumVersion(checksumVersion)
+			if err != nil {
+				panic(err)
+			}
+
+			changelog.OnChecksum(&changelog.ChecksumArgs{
+				Changelog: args[0],
+				Dialect:   dialect,
+				Version:   version,
+				Verify:    checksumVerify,
+				JSON:      checksumJSON,
+			})
+		},
+	}
+)
+
+func init() {
+	checksumCmd.PersistentFlags().StringVarP(&dialect, "dialect", "D", "", "Target database dialect, selecting the changeSets and properties by dbms")
+	checksumCmd.PersistentFlags().StringVar(&checksumVersion, "checksum-version", "9", "Checksum version: 8(Liquibase < 4.24) or 9")
+	checksumCmd.PersistentFlags().StringVar(&checksumVerify, "verify", "", "The databasechangelog.csv whose MD5SUM are verified")
+	checksumCmd.PersistentFlags().BoolVar(&checksumJSON, "json", false, "Print the checksums as JSON")
+}
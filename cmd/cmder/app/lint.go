@@ -0,0 +1,60 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package app
+
+import (
+	"os"
+
+	"github.com/photowey/liquigen/internal/cmd/changelog"
+	"github.com/photowey/liquigen/internal/cmd/database/lint"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintFailOn string
+	lintJSON   bool
+
+	lintCmd = &cobra.Command{
+		Use:   "lint <master.xml>",
+		Short: "Lint a Liquibase changelog and the changelogs it includes",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			failOn, err := lint.ParseSeverity(lintFailOn)
+			if err != nil {
+				panic(err)
+			}
+
+			lintArgz := &changelog.LintArgs{
+				Changelog: args[0],
+				Dialect:   dialect,
+				FailOn:    failOn,
+			}
+			if lintJSON {
+				lintArgz.JSON = os.Stdout
+				os.Stdout = os.Stderr
+			}
+
+			changelog.OnLint(lintArgz)
+		},
+	}
+)
+
+func init() {
+	lintCmd.PersistentFlags().StringVarP(&dialect, "dialect", "D", "", "Target database dialect, checking the dbms and the type properties against it")
+	lintCmd.PersistentFlags().StringVar(&lintFailOn, "fail-on", "error", "Severity failing the lint: warning or error")
+	lintCmd.PersistentFlags().BoolVar(&lintJSON, "json", false, "Print the problems as JSON")
+}
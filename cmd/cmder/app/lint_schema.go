@@ -0,0 +1,81 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package app
+
+import (
+	"os"
+
+	"github.com/photowey/liquigen/internal/cmd/changelog"
+	"github.com/photowey/liquigen/internal/cmd/database/lint"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintTargets []string
+
+	lintSchemaCmd = &cobra.Command{
+		Use:   "lint-schema",
+		Short: "Check the parsed, imported or introspected database schema against the lint rules of liquigen.json",
+		Run: func(cmd *cobra.Command, args []string) {
+			failOn, err := lint.ParseSeverity(lintFailOn)
+			if err != nil {
+				panic(err)
+			}
+
+			schemaLintArgz := &changelog.SchemaLintArgs{Targets: lintTargets, FailOn: failOn}
+			if lintJSON {
+				schemaLintArgz.JSON = os.Stdout
+				os.Stdout = os.Stderr
+			}
+
+			argz, err := populateArgs()
+			if err != nil {
+				panic(err)
+			}
+
+			changelog.OnSchemaLint(argz, schemaLintArgz)
+		},
+	}
+)
+
+func init() {
+	lintSchemaCmd.PersistentFlags().StringVar(&lintFailOn, "fail-on", "error", "Severity failing the lint: warning or error")
+	lintSchemaCmd.PersistentFlags().StringSliceVar(&lintTargets, "targets", nil,
+		"Target dialects of the reserved words and identifier length, e.g. postgres,oracle(default: lint.dialects of liquigen.json, or --dialect)")
+	lintSchemaCmd.PersistentFlags().BoolVar(&lintJSON, "json", false, "Print the problems as JSON")
+
+	// Database mode
+	lintSchemaCmd.PersistentFlags().StringVarP(&host, "host", "H", "", "Target database host")
+	lintSchemaCmd.PersistentFlags().IntVarP(&port, "port", "P", 0, "Target database port")
+	lintSchemaCmd.PersistentFlags().StringVarP(&username, "username", "u", "", "Target database authentication username")
+	lintSchemaCmd.PersistentFlags().StringVarP(&password, "password", "p", "", "Target database authentication password")
+	lintSchemaCmd.PersistentFlags().StringVarP(&dialect, "dialect", "D", "", "Target database dialect")
+	lintSchemaCmd.PersistentFlags().StringVarP(&database, "database", "d", "", "Target database name")
+	lintSchemaCmd.PersistentFlags().StringToStringVar(&params, "param", nil,
+		"Target database connection parameters, e.g. sslmode=verify-full(default: database.params of liquigen.json)")
+	lintSchemaCmd.PersistentFlags().IntVarP(&concurrency, "concurrency", "c", 0, "Number of tables introspected in parallel")
+	lintSchemaCmd.PersistentFlags().DurationVarP(&timeout, "timeout", "t", changelog.DefaultTimeout, "Timeout of database introspection")
+
+	// SQL file mode
+	lintSchemaCmd.PersistentFlags().StringVarP(&sqlFile, "sql", "s", "", "SQL file")
+
+	// Snapshot file mode
+	lintSchemaCmd.PersistentFlags().StringVarP(&snapshotFile, "snapshot", "S", "", "Snapshot file(.json|.yaml|.yml)")
+
+	// Liquibase changelog mode
+	lintSchemaCmd.PersistentFlags().StringVarP(&liquibaseFile, "liquibase", "L", "", "Liquibase master changelog(.xml)")
+}
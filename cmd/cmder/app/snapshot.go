@@ -0,0 +1,62 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package app
+
+import (
+	"github.com/photowey/liquigen/internal/cmd/changelog"
+	"github.com/spf13/cobra"
+)
+
+var (
+	snapshotOutput string
+
+	snapshotCmd = &cobra.Command{
+		Use:     "snapshot",
+		Aliases: []string{"snap"},
+		Short:   "Export the parsed, imported or introspected database schema to a snapshot file",
+		Run: func(cmd *cobra.Command, args []string) {
+			argz, err := populateArgs()
+			if err != nil {
+				panic(err)
+			}
+
+			changelog.OnSnapshotExport(argz, snapshotOutput)
+		},
+	}
+)
+
+func init() {
+	snapshotCmd.PersistentFlags().StringVarP(&snapshotOutput, "output", "o", "snapshot.json", "Snapshot file(.json|.yaml|.yml)")
+
+	// Database mode
+	snapshotCmd.PersistentFlags().StringVarP(&host, "host", "H", "", "Target database host")
+	snapshotCmd.PersistentFlags().IntVarP(&port, "port", "P", 0, "Target database port")
+	snapshotCmd.PersistentFlags().StringVarP(&username, "username", "u", "", "Target database authentication username")
+	snapshotCmd.PersistentFlags().StringVarP(&password, "password", "p", "", "Target database authentication password")
+	snapshotCmd.PersistentFlags().StringVarP(&dialect, "dialect", "D", "", "Target database dialect")
+	snapshotCmd.PersistentFlags().StringVarP(&database, "database", "d", "", "Target database name")
+	snapshotCmd.PersistentFlags().StringToStringVar(&params, "param", nil,
+		"Target database connection parameters, e.g. sslmode=verify-full(default: database.params of liquigen.json)")
+	snapshotCmd.PersistentFlags().IntVarP(&concurrency, "concurrency", "c", 0, "Number of tables introspected in parallel")
+	snapshotCmd.PersistentFlags().DurationVarP(&timeout, "timeout", "t", changelog.DefaultTimeout, "Timeout of database introspection")
+
+	// SQL file mode
+	snapshotCmd.PersistentFlags().StringVarP(&sqlFile, "sql", "s", "", "SQL file")
+
+	// Liquibase changelog mode
+	snapshotCmd.PersistentFlags().StringVarP(&liquibaseFile, "liquibase", "L", "", "Liquibase master changelog(.xml)")
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package app
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/photowey/liquigen/internal/cmd/changelog"
+	"github.com/spf13/cobra"
+)
+
+var (
+	templateExportOutput string
+
+	templateCmd = &cobra.Command{
+		Use:     "template",
+		Aliases: []string{"tmpl"},
+		Short:   "Manage the changelog and scaffold templates",
+	}
+
+	templateExportCmd = &cobra.Command{
+		Use:   "export",
+		Short: "Export the embedded templates, the starting point of a template set",
+		Long: `Export the embedded templates, the starting point of a template set.
+
+The changelog command renders the files of ~/.liquigen/templates/default, or of
+--templates, instead of the embedded templates of the same name, e.g.
+
+  $ liquigen template export -o ~/.liquigen/templates/default`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cwd, err := os.Getwd()
+			if err != nil {
+				panic(fmt.Errorf("get current working directory failed: %v", err))
+			}
+
+			mode, err := populateConflictMode()
+			if err != nil {
+				panic(err)
+			}
+
+			changelog.OnTemplateExport(&changelog.Args{
+				Cwd:      cwd,
+				Path:     cwd,
+				Conflict: mode,
+				DryRun:   dryRun,
+			}, templateExportOutput)
+		},
+	}
+)
+
+func init() {
+	templateExportCmd.PersistentFlags().StringVarP(&templateExportOutput, "output", "o", "templates", "Output directory")
+	templateExportCmd.PersistentFlags().StringVar(&conflict, "conflict", string(changelog.ConflictFail),
+		"How exported files replace existing ones(fail|skip|overwrite|new)")
+	templateExportCmd.PersistentFlags().BoolVar(&force, "force", false, "Overwrite existing files, same as --conflict overwrite")
+	templateExportCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "List the files to export without writing them")
+
+	templateCmd.AddCommand(templateExportCmd)
+}
@@ -26,8 +26,11 @@ import (
 var _config Config
 
 type Config struct {
-	Project  Project  `toml:"project" json:"project" yaml:"project"`
-	Database Database `toml:"database" json:"database" yaml:"database"`
+	Project   Project   `toml:"project" json:"project" yaml:"project"`
+	Database  Database  `toml:"database" json:"database" yaml:"database"`
+	Output    Output    `toml:"output" json:"output" yaml:"output"`
+	ChangeSet ChangeSet `toml:"changeSet" json:"changeSet" yaml:"changeSet"`
+	Lint      Lint      `toml:"lint" json:"lint" yaml:"lint"`
 }
 
 type Project struct {
@@ -36,19 +39,71 @@ type Project struct {
 	Version string `toml:"version" json:"version" yaml:"version"`
 	Dialect string `toml:"dialect" json:"dialect" yaml:"dialect"`
 	SQL     string `toml:"sql" json:"sql" yaml:"sql"`
+	Build   string `toml:"build" json:"build" yaml:"build"`
+
+	GroupId         string `toml:"groupId" json:"groupId" yaml:"groupId"`
+	ArtifactId      string `toml:"artifactId" json:"artifactId" yaml:"artifactId"`
+	ArtifactVersion string `toml:"artifactVersion" json:"artifactVersion" yaml:"artifactVersion"`
+	Package         string `toml:"package" json:"package" yaml:"package"`
+	ServerPort      int    `toml:"serverPort" json:"serverPort" yaml:"serverPort"`
+
+	JavaVersion       string            `toml:"javaVersion" json:"javaVersion" yaml:"javaVersion"`
+	SpringBootVersion string            `toml:"springBootVersion" json:"springBootVersion" yaml:"springBootVersion"`
+	LiquibaseVersion  string            `toml:"liquibaseVersion" json:"liquibaseVersion" yaml:"liquibaseVersion"`
+	Drivers           map[string]string `toml:"drivers" json:"drivers" yaml:"drivers"`
 }
 
 type Database struct {
-	Host     string   `toml:"host" json:"host" yaml:"host"`
-	Port     int      `toml:"port" json:"port" yaml:"port"`
-	Dialect  string   `toml:"dialect" json:"dialect" yaml:"dialect"`
-	Driver   string   `toml:"driver" json:"driver" yaml:"driver"`
-	Database string   `toml:"database" json:"database" yaml:"database"`
-	Username string   `toml:"username" json:"username" yaml:"username"`
-	Password string   `toml:"password" json:"password" yaml:"password"`
-	Includes []string `toml:"includes" json:"includes" yaml:"includes"`
-	Excludes []string `toml:"excludes" json:"excludes" yaml:"excludes"`
-	Prefixes []string `toml:"prefixes" json:"prefixes" yaml:"prefixes"`
+	Host        string   `toml:"host" json:"host" yaml:"host"`
+	Port        int      `toml:"port" json:"port" yaml:"port"`
+	Dialect     string   `toml:"dialect" json:"dialect" yaml:"dialect"`
+	Driver      string   `toml:"driver" json:"driver" yaml:"driver"`
+	Database    string   `toml:"database" json:"database" yaml:"database"`
+	Username    string   `toml:"username" json:"username" yaml:"username"`
+	Password    string   `toml:"password" json:"password" yaml:"password"`
+	Concurrency int      `toml:"concurrency" json:"concurrency" yaml:"concurrency"`
+	Includes    []string `toml:"includes" json:"includes" yaml:"includes"`
+	Excludes    []string `toml:"excludes" json:"excludes" yaml:"excludes"`
+	Prefixes    []string `toml:"prefixes" json:"prefixes" yaml:"prefixes"`
+	// Params the connection parameters of the DSN, e.g. sslmode of postgres.
+	Params map[string]string `toml:"params" json:"params" yaml:"params"`
+}
+
+type Output struct {
+	Dir      string `toml:"dir" json:"dir" yaml:"dir"`
+	Project  string `toml:"project" json:"project" yaml:"project"`
+	Path     string `toml:"path" json:"path" yaml:"path"`
+	File     string `toml:"file" json:"file" yaml:"file"`
+	Master   string `toml:"master" json:"master" yaml:"master"`
+	Scaffold string `toml:"scaffold" json:"scaffold" yaml:"scaffold"`
+	Baseline string `toml:"baseline" json:"baseline" yaml:"baseline"`
+}
+
+type ChangeSet struct {
+	ID       string                    `toml:"id" json:"id" yaml:"id"`
+	Sequence int                       `toml:"sequence" json:"sequence" yaml:"sequence"`
+	Author   string                    `toml:"author" json:"author" yaml:"author"`
+	Context  string                    `toml:"context" json:"context" yaml:"context"`
+	Labels   string                    `toml:"labels" json:"labels" yaml:"labels"`
+	Tables   map[string]ChangeSetTable `toml:"tables" json:"tables" yaml:"tables"`
+
+	Preconditions bool   `toml:"preconditions" json:"preconditions" yaml:"preconditions"`
+	OnFail        string `toml:"onFail" json:"onFail" yaml:"onFail"`
+	Quoting       string `toml:"quoting" json:"quoting" yaml:"quoting"`
+}
+
+type ChangeSetTable struct {
+	Context string `toml:"context" json:"context" yaml:"context"`
+	Labels  string `toml:"labels" json:"labels" yaml:"labels"`
+}
+
+type Lint struct {
+	Dialects         []string          `toml:"dialects" json:"dialects" yaml:"dialects"`
+	Rules            map[string]string `toml:"rules" json:"rules" yaml:"rules"`
+	IdentifierLength int               `toml:"identifierLength" json:"identifierLength" yaml:"identifierLength"`
+	VarcharLength    int               `toml:"varcharLength" json:"varcharLength" yaml:"varcharLength"`
+	AuditColumns     []string          `toml:"auditColumns" json:"auditColumns" yaml:"auditColumns"`
+	ReservedWords    []string          `toml:"reservedWords" json:"reservedWords" yaml:"reservedWords"`
 }
 
 func Init(configFile string) {
@@ -72,3 +127,15 @@ func ConfigProject() Project {
 func ConfigDatabase() Database {
 	return _config.Database
 }
+
+func ConfigOutput() Output {
+	return _config.Output
+}
+
+func ConfigChangeSet() ChangeSet {
+	return _config.ChangeSet
+}
+
+func ConfigLint() Lint {
+	return _config.Lint
+}
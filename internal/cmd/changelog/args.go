@@ -17,6 +17,9 @@
 package changelog
 
 import (
+	"io"
+	"time"
+
 	"github.com/photowey/liquigen/internal/cmd/database/ast"
 )
 
@@ -35,10 +38,41 @@ type Args struct {
 	Password string
 	Dialect  string
 	Database string
+	// Params the connection parameters of the DSN, e.g. sslmode=verify-full.
+	Params map[string]string
+
+	Concurrency int
+	Timeout     time.Duration
 
 	Format string
+	// Date the generation date, stamped into the changeSet ids and the changelog paths.
+	Date time.Time
+	// Project the coordinates and the versions of the scaffold.
+	Project *Project
+	// Templates the directory of the templates overriding the embedded ones, when set.
+	Templates string
+	// Scaffold what is generated besides the changelogs of the tables.
+	Scaffold Scaffold
+	// Baseline the format of the DATABASECHANGELOG sync artefact of the generated
+	// changeSets, none when blank.
+	Baseline Baseline
+	// ChangeSet how the id, author, context and labels of the changeSets are formed.
+	ChangeSet *ChangeSetPolicy
+	// Identifiers how the identifiers reserved, or too long, on the target dialects are handled.
+	Identifiers *IdentifierPolicy
+	// Layout where the generated files go, relative to Path.
+	Layout *Layout
+	// Conflict how the generated files replace the existing ones.
+	Conflict ConflictMode
+	// DryRun reports the generated files, and their diff, without writing them.
+	DryRun bool
+	// Stdout streams the changelog documents instead of writing the files, when set.
+	Stdout io.Writer
 
-	SQLFile string
-	SQL     string
-	Ast     *ast.Ast
+	SQLFile  string
+	SQL      string
+	Snapshot string
+	// Liquibase the master changelog to rebuild the schema from.
+	Liquibase string
+	Ast       *ast.Ast
 }
@@ -0,0 +1,343 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast/parser/mysql"
+	"github.com/photowey/liquigen/internal/cmd/database/liquibase"
+)
+
+// ----------------------------------------------------------------
+
+// Baseline the format of the DATABASECHANGELOG sync artefact, marking the generated
+// changeSets as already run on an existing database.
+type Baseline string
+
+const (
+	// BaselineNone generates no sync artefact.
+	BaselineNone Baseline = ""
+	// BaselineSQL an INSERT INTO DATABASECHANGELOG script, changelog-sync-v<version>.sql.
+	BaselineSQL Baseline = "sql"
+	// BaselineCSV the databasechangelog.csv of the Liquibase offline mode.
+	BaselineCSV Baseline = "csv"
+
+	BaselineCSVFile    = "databasechangelog.csv"
+	BaselineSQLFile    = "changelog-sync-v%s.sql"
+	ExecTypeExecuted   = "EXECUTED"
+	DateExecutedLayout = "2006-01-02T15:04:05.000"
+	DatabaseChangeLog  = "DATABASECHANGELOG"
+	ResourcesDir       = "src/main/resources/"
+	DeploymentIDLength = 10
+	CommentsSize       = 250
+)
+
+var _baselines = []Baseline{BaselineSQL, BaselineCSV}
+
+var _syncColumns = []string{
+	"ID", "AUTHOR", "FILENAME", "DATEEXECUTED", "ORDEREXECUTED", "EXECTYPE", "MD5SUM",
+	"DESCRIPTION", "COMMENTS", "TAG", "LIQUIBASE", "CONTEXTS", "LABELS", "DEPLOYMENT_ID",
+}
+
+// ----------------------------------------------------------------
+
+// ParseBaseline parses the format of the sync artefact, none when blank.
+func ParseBaseline(name string) (Baseline, error) {
+	if name == EmptyString {
+		return BaselineNone, nil
+	}
+
+	for _, baseline := range _baselines {
+		if strings.EqualFold(string(baseline), name) {
+			return baseline, nil
+		}
+	}
+
+	return BaselineNone, fmt.Errorf("unknown baseline %q, want sql or csv", name)
+}
+
+// ----------------------------------------------------------------
+
+// SyncRow one row of DATABASECHANGELOG.
+type SyncRow struct {
+	ID            string
+	Author        string
+	Filename      string
+	OrderExecuted int
+	MD5Sum        string
+	Description   string
+	Comments      string
+	Contexts      string
+	Labels        string
+}
+
+// writeBaseline plans the sync artefact of the changeSets of the changelogs planned into
+// output, once they are all planned.
+func writeBaseline(args *Args, output *Output) error {
+	rows, err := syncRows(args, output)
+	if err != nil {
+		return err
+	}
+
+	dir := outputLayout(args).BaselineDir(args)
+	switch args.Baseline {
+	case BaselineSQL:
+		path := filepath.Join(dir, fmt.Sprintf(BaselineSQLFile, args.Version))
+		output.Add(path, []byte(renderSyncSQL(args, rows)), 0o644)
+	case BaselineCSV:
+		path := filepath.Join(dir, BaselineCSVFile)
+		existing, err := os.ReadFile(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		content, err := renderSyncCSV(args, existing, rows)
+		if err != nil {
+			return fmt.Errorf("baseline: %s: %v", path, err)
+		}
+		output.AddUpdate(path, content, 0o644)
+	}
+
+	return nil
+}
+
+// syncRows the rows of the changeSets of the changelogs generated from the schema, in order;
+// the changelogs of the scaffold, e.g. the example changelog, are left to Liquibase, their
+// changeSets being run against the database.
+//
+// The MD5SUM is the checksum of the Liquibase version of the project, left NULL for the
+// changeSets whose checksum can't be computed, Liquibase filling it on the next update.
+func syncRows(args *Args, output *Output) ([]*SyncRow, error) {
+	properties, err := baselineProperties(args, output)
+	if err != nil {
+		return nil, err
+	}
+	version := liquibase.ChecksumVersionOf(projectOf(args).LiquibaseVersion)
+
+	var rows []*SyncRow
+	for _, file := range output.Files() {
+		if !file.Changelog || !strings.HasSuffix(file.Path, liquibase.ChangelogSuffix) {
+			continue
+		}
+
+		changeSets, err := liquibase.ReadChangeSets(bytes.NewReader(file.Content))
+		if errors.Is(err, liquibase.ErrNotChangelog) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("baseline: %s: %v", file.Path, err)
+		}
+
+		filename := changelogFilename(args, file.Path)
+		for _, changeSet := range changeSets {
+			checksum, err := changeSet.Checksum(version, properties)
+			if err != nil && !errors.Is(err, liquibase.ErrUnsupportedChecksum) {
+				return nil, fmt.Errorf("baseline: %s: %v", file.Path, err)
+			}
+
+			rows = append(rows, &SyncRow{
+				ID:            changeSet.ID,
+				Author:        changeSet.Author,
+				Filename:      filename,
+				OrderExecuted: len(rows) + 1,
+				MD5Sum:        checksum,
+				Description:   changeSet.Description(),
+				Comments:      liquibase.LimitSize(changeSet.Comment, CommentsSize),
+				Contexts:      changeSet.Context,
+				Labels:        changeSet.Labels,
+			})
+		}
+	}
+
+	return rows, nil
+}
+
+// baselineProperties the properties the changeSets are checksummed with: those of the
+// planned changelogs, e.g. the global types changelog, then those of the master changelog
+// already written.
+func baselineProperties(args *Args, output *Output) (map[string]string, error) {
+	properties := make(map[string]string)
+	merge := func(from map[string]string) {
+		for name, value := range from {
+			if _, ok := properties[name]; !ok {
+				properties[name] = value
+			}
+		}
+	}
+
+	planned := make(map[string]bool)
+	for _, file := range output.Files() {
+		planned[file.Path] = true
+		if !strings.HasSuffix(file.Path, liquibase.ChangelogSuffix) {
+			continue
+		}
+
+		read, err := liquibase.ReadProperties(bytes.NewReader(file.Content), args.Dialect)
+		if errors.Is(err, liquibase.ErrNotChangelog) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("baseline: %s: %v", file.Path, err)
+		}
+		merge(read)
+	}
+
+	master := outputLayout(args).MasterFile(args)
+	if _, err := os.Stat(master); err != nil || planned[master] {
+		return properties, nil
+	}
+
+	result, err := liquibase.Import(master, args.Dialect)
+	if err != nil {
+		return nil, fmt.Errorf("baseline: %v", err)
+	}
+	merge(result.Properties)
+
+	return properties, nil
+}
+
+// changelogFilename the FILENAME Liquibase records for the changelog: its path relative to
+// the resources of the project, the classpath root, else to the master changelog.
+func changelogFilename(args *Args, path string) string {
+	return classpathFilename(path, filepath.Dir(outputLayout(args).MasterFile(args)))
+}
+
+// classpathFilename the path relative to the resources directory above it, else to dir.
+func classpathFilename(path, dir string) string {
+	slashed := filepath.ToSlash(path)
+	if index := strings.LastIndex(slashed, "/"+ResourcesDir); index >= 0 {
+		return slashed[index+len(ResourcesDir)+1:]
+	}
+
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return slashed
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// ----------------------------------------------------------------
+
+func renderSyncSQL(args *Args, rows []*SyncRow) string {
+	dateTime := "TIMESTAMP"
+	if args.Dialect == mysql.Dialect {
+		dateTime = "DATETIME"
+	}
+
+	var buf strings.Builder
+	buf.WriteString(fmt.Sprintf("-- Marks the changeSets of the version %s as run, without running them.\n", args.Version))
+	buf.WriteString(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (ID VARCHAR(255) NOT NULL, AUTHOR VARCHAR(255) NOT NULL, "+
+		"FILENAME VARCHAR(255) NOT NULL, DATEEXECUTED %s NOT NULL, ORDEREXECUTED INT NOT NULL, EXECTYPE VARCHAR(10) NOT NULL, "+
+		"MD5SUM VARCHAR(35), DESCRIPTION VARCHAR(255), COMMENTS VARCHAR(255), TAG VARCHAR(255), LIQUIBASE VARCHAR(20), "+
+		"CONTEXTS VARCHAR(255), LABELS VARCHAR(255), DEPLOYMENT_ID VARCHAR(10));\n", DatabaseChangeLog, dateTime))
+
+	for _, row := range rows {
+		values := []string{
+			quoteSQL(row.ID), quoteSQL(row.Author), quoteSQL(row.Filename), "CURRENT_TIMESTAMP",
+			strconv.Itoa(row.OrderExecuted), quoteSQL(ExecTypeExecuted), nullableSQL(row.MD5Sum),
+			quoteSQL(row.Description), quoteSQL(row.Comments), "NULL", quoteSQL(projectOf(args).LiquibaseVersion),
+			nullableSQL(row.Contexts), nullableSQL(row.Labels), quoteSQL(deploymentID(args)),
+		}
+
+		buf.WriteString(fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);\n",
+			DatabaseChangeLog, strings.Join(_syncColumns, ", "), strings.Join(values, ", ")))
+	}
+
+	return buf.String()
+}
+
+// renderSyncCSV the rows appended to the existing databasechangelog.csv, replacing the
+// rows of the same changeSets.
+func renderSyncCSV(args *Args, existing []byte, rows []*SyncRow) ([]byte, error) {
+	records := [][]string{_syncColumns}
+	if len(existing) > 0 {
+		read, err := csv.NewReader(bytes.NewReader(existing)).ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		if len(read) > 0 {
+			records = read
+		}
+	}
+
+	replaced := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		replaced[row.ID+"\x00"+row.Author+"\x00"+row.Filename] = true
+	}
+
+	kept, order := records[:1], 0
+	for _, record := range records[1:] {
+		if len(record) < 5 || replaced[record[0]+"\x00"+record[1]+"\x00"+record[2]] {
+			continue
+		}
+
+		kept = append(kept, record)
+		if n, err := strconv.Atoi(record[4]); err == nil && n > order {
+			order = n
+		}
+	}
+
+	dateExecuted := generationDate(args).Format(DateExecutedLayout)
+	for _, row := range rows {
+		kept = append(kept, []string{
+			row.ID, row.Author, row.Filename, dateExecuted, strconv.Itoa(order + row.OrderExecuted), ExecTypeExecuted,
+			row.MD5Sum, row.Description, row.Comments, EmptyString, projectOf(args).LiquibaseVersion,
+			row.Contexts, row.Labels, deploymentID(args),
+		})
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.WriteAll(kept); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// deploymentID the DEPLOYMENT_ID of the sync, the last digits of the generation time in
+// milliseconds as Liquibase does.
+func deploymentID(args *Args) string {
+	id := strconv.FormatInt(generationDate(args).UnixMilli(), 10)
+	if len(id) > DeploymentIDLength {
+		return id[len(id)-DeploymentIDLength:]
+	}
+
+	return id
+}
+
+func quoteSQL(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func nullableSQL(value string) string {
+	if value == EmptyString {
+		return "NULL"
+	}
+
+	return quoteSQL(value)
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const baselineChangelog = `<?xml version="1.0" encoding="UTF-8"?>
+<databaseChangeLog xmlns="http://www.liquibase.org/xml/ns/dbchangelog">
+    <changeSet id="%s" author="dev" context="dev" labels="v1.0.0">
+        <comment>Initialize the table: it's %s</comment>
+        <createTable tableName="%s"/>
+    </changeSet>
+</databaseChangeLog>`
+
+func baselineOutput(args *Args, tables ...string) *Output {
+	output := NewOutput(args)
+	output.Add(filepath.Join(args.Path, "liquibase-changelog", "pom.xml"), []byte(`<project/>`), 0o644)
+	for _, table := range tables {
+		path := outputLayout(args).TableChangelogFile(args, table, "20241027")
+		output.AddChangelog(path, []byte(strings.ReplaceAll(baselineChangelog, "%s", table)), 0o644)
+	}
+
+	return output
+}
+
+func TestParseBaseline(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Baseline
+		wantErr bool
+	}{
+		{name: "", want: BaselineNone},
+		{name: "sql", want: BaselineSQL},
+		{name: "CSV", want: BaselineCSV},
+		{name: "yaml", want: BaselineNone, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBaseline(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseBaseline() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseBaseline() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChangelogFilename(t *testing.T) {
+	args := &Args{Path: filepath.FromSlash("/repo"), Dialect: "mysql", Version: "1.0.0"}
+
+	tests := []struct {
+		name   string
+		layout *Layout
+		path   string
+		want   string
+	}{
+		{name: "resources", layout: DefaultLayout(),
+			path: "/repo/liquibase-changelog/src/main/resources/liquibase/mysql/changelogs/v1.0.0/employee_1.0.0.xml",
+			want: "liquibase/mysql/changelogs/v1.0.0/employee_1.0.0.xml"},
+		{name: "master directory", layout: &Layout{Master: "db/changelog/{{dialect}}/master.xml"},
+			path: "/repo/db/changelog/mysql/1.0.0/employee.xml",
+			want: "1.0.0/employee.xml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			argz := *args
+			argz.Layout = tt.layout
+			if got := changelogFilename(&argz, filepath.FromSlash(tt.path)); got != tt.want {
+				t.Errorf("changelogFilename() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteBaseline_sql(t *testing.T) {
+	args := &Args{Path: t.TempDir(), Dialect: "mysql", Version: "1.0.0", Baseline: BaselineSQL}
+	output := baselineOutput(args, "employee", "department")
+
+	if err := writeBaseline(args, output); err != nil {
+		t.Fatalf("writeBaseline() error = %v", err)
+	}
+
+	files := output.Files()
+	sync := files[len(files)-1]
+	if want := filepath.Join(args.Path, "liquibase-baseline", "mysql", "changelog-sync-v1.0.0.sql"); sync.Path != want {
+		t.Fatalf("writeBaseline() path = %s, want %s", sync.Path, want)
+	}
+
+	for _, want := range []string{
+		"CREATE TABLE IF NOT EXISTS DATABASECHANGELOG (",
+		"DATEEXECUTED DATETIME NOT NULL",
+		"VALUES ('employee', 'dev', 'liquibase/mysql/changelogs/v1.0.0/employee_1.0.0.xml', CURRENT_TIMESTAMP, 1, 'EXECUTED', '8:0a4dbc882adc5e97dff5d299f0fd67ff', " +
+			"'createTable tableName=employee', 'Initialize the table: it''s employee', NULL, '4.9.1', 'dev', 'v1.0.0'",
+		"VALUES ('department', 'dev', 'liquibase/mysql/changelogs/v1.0.0/department_1.0.0.xml', CURRENT_TIMESTAMP, 2,",
+	} {
+		if !strings.Contains(string(sync.Content), want) {
+			t.Errorf("writeBaseline() got:\n%s\nwant it to contain %s", sync.Content, want)
+		}
+	}
+}
+
+func TestSyncRows_example(t *testing.T) {
+	args := &Args{Path: t.TempDir(), Dialect: "mysql", Version: "1.0.0", Baseline: BaselineSQL}
+	output := baselineOutput(args, "employee")
+	example := filepath.Join(args.Path, "liquibase-changelog", "src", "main", "resources", "liquibase", "mysql",
+		"changelogs", "v1.0.0", "example_employee_1.0.0.xml")
+	output.Add(example, []byte(strings.ReplaceAll(baselineChangelog, "%s", "example_employee")), 0o755)
+
+	rows, err := syncRows(args, output)
+	if err != nil {
+		t.Fatalf("syncRows() error = %v", err)
+	}
+
+	var got []string
+	for _, row := range rows {
+		got = append(got, row.ID)
+	}
+	if want := []string{"employee"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("syncRows() got = %v, want %v", got, want)
+	}
+}
+
+func TestRenderSyncCSV(t *testing.T) {
+	args := &Args{Path: filepath.FromSlash("/repo"), Dialect: "mysql", Version: "1.0.1",
+		Date: time.Date(2024, 10, 27, 0, 0, 0, 0, time.UTC)}
+	existing := `ID,AUTHOR,FILENAME,DATEEXECUTED,ORDEREXECUTED,EXECTYPE,MD5SUM,DESCRIPTION,COMMENTS,TAG,LIQUIBASE,CONTEXTS,LABELS,DEPLOYMENT_ID
+a,dev,a.xml,2024-10-01T00:00:00.000,1,EXECUTED,,createTable tableName=a,,,4.9.1,,,1
+b,dev,b.xml,2024-10-01T00:00:00.000,2,EXECUTED,,createTable tableName=b,,,4.9.1,,,1
+`
+	rows := []*SyncRow{
+		{ID: "b", Author: "dev", Filename: "b.xml", OrderExecuted: 1, Description: "createTable tableName=b"},
+		{ID: "c", Author: "dev", Filename: "c.xml", OrderExecuted: 2, Description: "createTable tableName=c", Contexts: "dev,test"},
+	}
+
+	got, err := renderSyncCSV(args, []byte(existing), rows)
+	if err != nil {
+		t.Fatalf("renderSyncCSV() error = %v", err)
+	}
+
+	want := `ID,AUTHOR,FILENAME,DATEEXECUTED,ORDEREXECUTED,EXECTYPE,MD5SUM,DESCRIPTION,COMMENTS,TAG,LIQUIBASE,CONTEXTS,LABELS,DEPLOYMENT_ID
+a,dev,a.xml,2024-10-01T00:00:00.000,1,EXECUTED,,createTable tableName=a,,,4.9.1,,,1
+b,dev,b.xml,2024-10-27T00:00:00.000,2,EXECUTED,,createTable tableName=b,,,4.9.1,,,9987200000
+c,dev,c.xml,2024-10-27T00:00:00.000,3,EXECUTED,,createTable tableName=c,,,4.9.1,"dev,test",,9987200000
+`
+	if string(got) != want {
+		t.Errorf("renderSyncCSV() got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+// ----------------------------------------------------------------
+
+const (
+	ChangeLogTemplate = `<?xml version="1.0" encoding="UTF-8"?>
+<databaseChangeLog
+        xmlns="http://www.liquibase.org/xml/ns/dbchangelog"
+        xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+        xsi:schemaLocation="http://www.liquibase.org/xml/ns/dbchangelog
+        http://www.liquibase.org/xml/ns/dbchangelog/dbchangelog-4.9.xsd">
+{{ range .ChangeSets }}
+    <changeSet id="{{- xml .ID -}}"
+				author="{{- xml .Author -}}"
+				dbms="{{- $.Dialect -}}"
+				context="{{- xml .Context -}}"
+				labels="{{- xml .Labels -}}"
+				{{- if .ObjectQuotingStrategy }} objectQuotingStrategy="{{ .ObjectQuotingStrategy }}"{{ end }}>
+        {{- if .Preconditions }}
+        {{ .Preconditions }}
+        {{- end }}
+
+        <comment>{{- xml .Comment -}}</comment>
+
+        {{ .Change }}
+    </changeSet>
+{{ end }}
+</databaseChangeLog>`
+
+	CreateTableChangeTemplate = `<createTable tableName="{{ xml .Table }}" remarks="{{ xml .Remarks }}">
+            {{- range .Columns }}
+            {{ .Element }}
+            {{- end }}
+        </createTable>`
+	DropTableChangeTemplate       = `<dropTable tableName="{{ xml .Table }}"/>`
+	SetTableRemarksChangeTemplate = `<setTableRemarks tableName="{{ xml .Table }}" remarks="{{ xml .Remarks }}"/>`
+	AddColumnChangeTemplate       = `<addColumn tableName="{{ xml .Table }}">
+            {{ .Column.Element }}
+        </addColumn>`
+	DropColumnChangeTemplate       = `<dropColumn tableName="{{ xml .Table }}" columnName="{{ xml .Column.Name }}"/>`
+	ModifyDataTypeChangeTemplate   = `<modifyDataType tableName="{{ xml .Table }}" columnName="{{ xml .Column.Name }}" newDataType="{{ .DataType }}"/>`
+	AddNotNullChangeTemplate       = `<addNotNullConstraint tableName="{{ xml .Table }}" columnName="{{ xml .Column.Name }}" columnDataType="{{ .DataType }}"/>`
+	DropNotNullChangeTemplate      = `<dropNotNullConstraint tableName="{{ xml .Table }}" columnName="{{ xml .Column.Name }}" columnDataType="{{ .DataType }}"/>`
+	AddDefaultValueChangeTemplate  = `<addDefaultValue tableName="{{ xml .Table }}" columnName="{{ xml .Column.Name }}" columnDataType="{{ .DataType }}" {{ .Column.DefaultValue }}/>`
+	DropDefaultValueChangeTemplate = `<dropDefaultValue tableName="{{ xml .Table }}" columnName="{{ xml .Column.Name }}" columnDataType="{{ .DataType }}"/>`
+	SetColumnRemarksChangeTemplate = `<setColumnRemarks tableName="{{ xml .Table }}" columnName="{{ xml .Column.Name }}" columnDataType="{{ .DataType }}" remarks="{{ xml .Column.Comment }}"/>`
+	CreateIndexChangeTemplate      = `<createIndex tableName="{{ xml .Table }}" indexName="{{ xml .Index.Name }}" unique="{{ .Index.Unique }}">
+            {{- range .Index.Columns }}
+            <column name="{{ xml . }}"/>
+            {{- end }}
+        </createIndex>`
+	DropIndexChangeTemplate    = `<dropIndex tableName="{{ xml .Table }}" indexName="{{ xml .Index.Name }}"/>`
+	RenameTableChangeTemplate  = `<renameTable oldTableName="{{ xml .OldTable }}" newTableName="{{ xml .Table }}"/>`
+	RenameColumnChangeTemplate = `<renameColumn tableName="{{ xml .Table }}" oldColumnName="{{ xml .OldColumn }}" newColumnName="{{ xml .Column.Name }}" columnDataType="{{ .DataType }}"/>`
+)
@@ -0,0 +1,242 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/photowey/liquigen/configs"
+	"github.com/photowey/liquigen/pkg/stringz"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	DefaultChangeSetID       = "{{table}}_{{date}}_{{seq}}"
+	DefaultChangeSetSequence = 3
+	DefaultChangeSetAuthor   = "{{author}}"
+	DefaultChangeSetContext  = "dev,test,stage,prod"
+	DefaultChangeSetLabels   = "v{{version}}"
+)
+
+var (
+	_changeSetIDPlaceholders     = []string{"table", "date", "version", "dialect", "seq"}
+	_changeSetAuthorPlaceholders = []string{"author", "email"}
+	_changeSetPlaceholders       = []string{"table", "version", "dialect"}
+)
+
+// ----------------------------------------------------------------
+
+// ChangeSetPolicy how the attributes of the generated changeSets are formed.
+//
+// The id pattern accepts the {{table}}, {{date}}, {{version}}, {{dialect}} and {{seq}}
+// placeholders, {{seq}} being the position of the changeSet in its changelog, zero padded
+// to Sequence digits; the author pattern accepts {{author}} and {{email}}, and the
+// context and labels patterns {{table}}, {{version}} and {{dialect}}.
+type ChangeSetPolicy struct {
+	// ID the id pattern, e.g. v{{version}}-{{table}}-{{seq}}.
+	ID string
+	// Sequence the number of digits of {{seq}}.
+	Sequence int
+	// Author the author pattern, e.g. {{author}} <{{email}}>.
+	Author string
+	// Context the context pattern of every table.
+	Context string
+	// Labels the labels pattern of every table.
+	Labels string
+	// Tables the context and labels patterns of some tables, overriding the global ones.
+	Tables map[string]*ChangeSetTable
+	// Preconditions whether the changeSets check, before they run, that the schema is in
+	// the expected state, e.g. that the created table does not exist yet.
+	Preconditions bool
+	// OnFail what Liquibase does with a changeSet whose preconditions fail.
+	OnFail OnFail
+}
+
+// ChangeSetTable the context and labels patterns of the changeSets of one table, the blank
+// ones falling back to the global patterns.
+type ChangeSetTable struct {
+	Context string
+	Labels  string
+}
+
+func DefaultChangeSetPolicy() *ChangeSetPolicy {
+	return &ChangeSetPolicy{
+		ID:       DefaultChangeSetID,
+		Sequence: DefaultChangeSetSequence,
+		Author:   DefaultChangeSetAuthor,
+		Context:  DefaultChangeSetContext,
+		Labels:   DefaultChangeSetLabels,
+		Tables:   make(map[string]*ChangeSetTable),
+		OnFail:   OnFailMarkRan,
+	}
+}
+
+// ConfigChangeSetPolicy the policy of the changeSet section of liquigen.json, the blank
+// patterns falling back to the default policy.
+func ConfigChangeSetPolicy() (*ChangeSetPolicy, error) {
+	changeSet := configs.ConfigChangeSet()
+	policy := DefaultChangeSetPolicy()
+
+	if stringz.IsNotBlankString(changeSet.ID) {
+		policy.ID = changeSet.ID
+	}
+	if changeSet.Sequence > 0 {
+		policy.Sequence = changeSet.Sequence
+	}
+	if stringz.IsNotBlankString(changeSet.Author) {
+		policy.Author = changeSet.Author
+	}
+	if stringz.IsNotBlankString(changeSet.Context) {
+		policy.Context = changeSet.Context
+	}
+	if stringz.IsNotBlankString(changeSet.Labels) {
+		policy.Labels = changeSet.Labels
+	}
+	for table, patterns := range changeSet.Tables {
+		policy.Tables[table] = &ChangeSetTable{Context: patterns.Context, Labels: patterns.Labels}
+	}
+
+	onFail, err := ParseOnFail(changeSet.OnFail)
+	if err != nil {
+		return nil, err
+	}
+	policy.Preconditions = changeSet.Preconditions
+	policy.OnFail = onFail
+
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// ----------------------------------------------------------------
+
+// ChangeSet the attributes of the seq-th changeSet(1-based) of the changelog of table.
+func (p *ChangeSetPolicy) ChangeSet(args *Args, table, date string, seq int) *ChangeSet {
+	context, labels := p.Context, p.Labels
+	if patterns, ok := p.Tables[table]; ok {
+		if stringz.IsNotBlankString(patterns.Context) {
+			context = patterns.Context
+		}
+		if stringz.IsNotBlankString(patterns.Labels) {
+			labels = patterns.Labels
+		}
+	}
+
+	return &ChangeSet{
+		ID:      p.expand(args, p.ID, table, date, seq),
+		Author:  p.expand(args, p.Author, table, date, seq),
+		Context: p.expand(args, context, table, date, seq),
+		Labels:  p.expand(args, labels, table, date, seq),
+	}
+}
+
+// preconditions the <preConditions> element of the checks, blank when the policy emits none.
+func (p *ChangeSetPolicy) preconditions(checks []*Precondition) string {
+	if !p.Preconditions {
+		return EmptyString
+	}
+
+	return renderPreconditions(p.OnFail, checks)
+}
+
+// RequiresEmail whether the author pattern uses the {{email}} placeholder.
+func (p *ChangeSetPolicy) RequiresEmail() bool {
+	return stringz.ArrayContains(placeholdersOf(p.Author), "email")
+}
+
+func (p *ChangeSetPolicy) expand(args *Args, pattern, table, date string, seq int) string {
+	return _placeholderRegexp.ReplaceAllStringFunc(pattern, func(match string) string {
+		switch _placeholderRegexp.FindStringSubmatch(match)[1] {
+		case "table":
+			return table
+		case "date":
+			return date
+		case "version":
+			return args.Version
+		case "dialect":
+			return args.Dialect
+		case "seq":
+			return fmt.Sprintf("%0*d", p.Sequence, seq)
+		case "author":
+			return args.Author
+		default:
+			return args.Email
+		}
+	})
+}
+
+func (p *ChangeSetPolicy) validate() error {
+	if err := validatePlaceholders("id", p.ID, _changeSetIDPlaceholders); err != nil {
+		return err
+	}
+	if stringz.ArrayNotContains(placeholdersOf(p.ID), "seq") {
+		return fmt.Errorf("changeSet: the id pattern %s misses the {{seq}} placeholder", p.ID)
+	}
+	if err := validatePlaceholders("author", p.Author, _changeSetAuthorPlaceholders); err != nil {
+		return err
+	}
+	if err := validatePlaceholders("context", p.Context, _changeSetPlaceholders); err != nil {
+		return err
+	}
+	if err := validatePlaceholders("labels", p.Labels, _changeSetPlaceholders); err != nil {
+		return err
+	}
+
+	for table, patterns := range p.Tables {
+		if err := validatePlaceholders(table+".context", patterns.Context, _changeSetPlaceholders); err != nil {
+			return err
+		}
+		if err := validatePlaceholders(table+".labels", patterns.Labels, _changeSetPlaceholders); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validatePlaceholders(name, pattern string, placeholders []string) error {
+	for _, placeholder := range placeholdersOf(pattern) {
+		if stringz.ArrayNotContains(placeholders, placeholder) {
+			return fmt.Errorf("changeSet: unknown placeholder {{%s}} in the %s pattern %s, want one of {{%s}}",
+				placeholder, name, pattern, strings.Join(placeholders, "}}, {{"))
+		}
+	}
+
+	return nil
+}
+
+func placeholdersOf(pattern string) []string {
+	var placeholders []string
+	for _, match := range _placeholderRegexp.FindAllStringSubmatch(pattern, -1) {
+		placeholders = append(placeholders, match[1])
+	}
+
+	return placeholders
+}
+
+// changeSetPolicy the changeSet policy of args, the default one when unset.
+func changeSetPolicy(args *Args) *ChangeSetPolicy {
+	if args.ChangeSet == nil {
+		return DefaultChangeSetPolicy()
+	}
+
+	return args.ChangeSet
+}
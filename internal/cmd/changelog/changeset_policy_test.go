@@ -0,0 +1,117 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChangeSetPolicy_ChangeSet(t *testing.T) {
+	args := &Args{Author: "dev", Email: "dev@example.com", Version: "1.0.1", Dialect: "mysql"}
+
+	tests := []struct {
+		name   string
+		policy *ChangeSetPolicy
+		table  string
+		seq    int
+		want   *ChangeSet
+	}{
+		{
+			name:   "default policy",
+			policy: DefaultChangeSetPolicy(),
+			table:  "employee",
+			seq:    2,
+			want: &ChangeSet{
+				ID: "employee_20241027_002", Author: "dev", Context: "dev,test,stage,prod", Labels: "v1.0.1",
+			},
+		},
+		{
+			name: "version prefix without date",
+			policy: &ChangeSetPolicy{
+				ID: "v{{version}}-{{table}}-{{seq}}", Sequence: 1, Author: "{{author}} <{{email}}>",
+				Context: "dev", Labels: "{{dialect}},v{{version}}",
+			},
+			table: "employee",
+			seq:   12,
+			want: &ChangeSet{
+				ID: "v1.0.1-employee-12", Author: "dev <dev@example.com>", Context: "dev", Labels: "mysql,v1.0.1",
+			},
+		},
+		{
+			name: "table patterns",
+			policy: &ChangeSetPolicy{
+				ID: "{{seq}}", Sequence: 4, Author: "{{author}}", Context: "dev", Labels: "v{{version}}",
+				Tables: map[string]*ChangeSetTable{"employee": {Context: "hr,{{table}}"}},
+			},
+			table: "employee",
+			seq:   1,
+			want: &ChangeSet{
+				ID: "0001", Author: "dev", Context: "hr,employee", Labels: "v1.0.1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.ChangeSet(args, tt.table, "20241027", tt.seq); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChangeSet() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChangeSetPolicy_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(policy *ChangeSetPolicy)
+		wantErr bool
+	}{
+		{name: "default policy", update: func(policy *ChangeSetPolicy) {}},
+		{name: "id without seq", update: func(policy *ChangeSetPolicy) { policy.ID = "{{table}}_{{date}}" }, wantErr: true},
+		{name: "unknown id placeholder", update: func(policy *ChangeSetPolicy) { policy.ID = "{{schema}}_{{seq}}" }, wantErr: true},
+		{name: "seq in labels", update: func(policy *ChangeSetPolicy) { policy.Labels = "{{seq}}" }, wantErr: true},
+		{name: "table in author", update: func(policy *ChangeSetPolicy) { policy.Author = "{{table}}" }, wantErr: true},
+		{
+			name: "unknown table placeholder",
+			update: func(policy *ChangeSetPolicy) {
+				policy.Tables["employee"] = &ChangeSetTable{Context: "{{email}}"}
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := DefaultChangeSetPolicy()
+			tt.update(policy)
+			if err := policy.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChangeSetPolicy_RequiresEmail(t *testing.T) {
+	policy := DefaultChangeSetPolicy()
+	if policy.RequiresEmail() {
+		t.Errorf("RequiresEmail() of %s got = true, want false", policy.Author)
+	}
+
+	policy.Author = "{{ author }} <{{ email }}>"
+	if !policy.RequiresEmail() {
+		t.Errorf("RequiresEmail() of %s got = false, want true", policy.Author)
+	}
+}
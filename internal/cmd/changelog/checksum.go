@@ -0,0 +1,219 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/photowey/liquigen/internal/cmd/database/liquibase"
+	"github.com/photowey/liquigen/pkg/filez"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	ChecksumOK          = "ok"
+	ChecksumModified    = "modified"
+	ChecksumNotRun      = "not run"
+	ChecksumUnsupported = "unsupported"
+)
+
+// ----------------------------------------------------------------
+
+// ChecksumArgs the arguments of the checksum command.
+type ChecksumArgs struct {
+	// Changelog the changelog, usually a master.xml, its included changelogs followed.
+	Changelog string
+	Dialect   string
+	Version   liquibase.ChecksumVersion
+	// Verify the databasechangelog.csv the checksums are compared with, blank for none.
+	Verify string
+	JSON   bool
+}
+
+// ChangeSetChecksum the checksum of one changeSet of a changelog.
+type ChangeSetChecksum struct {
+	Filename string `json:"filename"`
+	ID       string `json:"id"`
+	Author   string `json:"author"`
+	Checksum string `json:"checksum,omitempty"`
+	// Recorded the MD5SUM of the databasechangelog.csv verified against.
+	Recorded string `json:"recorded,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ----------------------------------------------------------------
+
+// Checksums computes the checksums of the changeSets of the changelog and of the changelogs
+// it includes, skipping those whose dbms excludes dialect. The FILENAME is the path relative
+// to the resources directory, else to the changelog directory, as Liquibase records it.
+func Checksums(path, dialect string, version liquibase.ChecksumVersion) ([]*ChangeSetChecksum, error) {
+	path, err := filez.Clean(path)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := liquibase.Import(path, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	var checksums []*ChangeSetChecksum
+	for _, file := range result.Files {
+		changeSets, err := readChangeSets(file)
+		if err != nil {
+			return nil, err
+		}
+
+		filename := classpathFilename(file, filepath.Dir(path))
+		for _, changeSet := range changeSets {
+			if !liquibase.MatchDbms(changeSet.Dbms, dialect) {
+				continue
+			}
+
+			checksum := &ChangeSetChecksum{Filename: filename, ID: changeSet.ID, Author: changeSet.Author}
+			if checksum.Checksum, err = changeSet.Checksum(version, result.Properties); err != nil {
+				checksum.Status, checksum.Error = ChecksumUnsupported, err.Error()
+			}
+			checksums = append(checksums, checksum)
+		}
+	}
+
+	return checksums, nil
+}
+
+func readChangeSets(path string) ([]*liquibase.ChangeSet, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	changeSets, err := liquibase.ReadChangeSets(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return changeSets, nil
+}
+
+// ----------------------------------------------------------------
+
+// OnChecksum prints the checksums of the changeSets of the changelog, compared with the
+// MD5SUM recorded in the databasechangelog.csv when verifying, and exits non-zero when a
+// changelog can't be read, a checksum can't be computed or an applied changeSet was modified.
+func OnChecksum(args *ChecksumArgs) {
+	checksums, err := Checksums(args.Changelog, args.Dialect, args.Version)
+	if err != nil {
+		fmt.Println(red(fmt.Sprintf("Checksum: %v", err)))
+		os.Exit(1)
+	}
+
+	if args.Verify != "" {
+		if err = verifyChecksums(args, checksums); err != nil {
+			fmt.Println(red(fmt.Sprintf("Checksum: %v", err)))
+			os.Exit(1)
+		}
+	}
+
+	failed := false
+	for _, checksum := range checksums {
+		failed = failed || checksum.Status == ChecksumUnsupported || checksum.Status == ChecksumModified
+	}
+
+	if args.JSON {
+		bytes, err := json.MarshalIndent(checksums, EmptyString, "  ")
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(string(bytes))
+	} else {
+		printChecksums(checksums)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// verifyChecksums compares the checksums with the recorded ones, of the same checksum
+// version, the changeSets missing from the databasechangelog.csv being not run.
+func verifyChecksums(args *ChecksumArgs, checksums []*ChangeSetChecksum) error {
+	file, err := os.Open(args.Verify)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return fmt.Errorf("%s: %w", args.Verify, err)
+	}
+
+	recorded := make(map[string]string, len(records))
+	for _, record := range records {
+		if len(record) > 6 {
+			recorded[record[0]+"\x00"+record[1]+"\x00"+record[2]] = record[6]
+		}
+	}
+
+	for _, checksum := range checksums {
+		if checksum.Status == ChecksumUnsupported {
+			continue
+		}
+
+		md5Sum, ok := recorded[checksum.ID+"\x00"+checksum.Author+"\x00"+checksum.Filename]
+		if !ok {
+			checksum.Status = ChecksumNotRun
+			continue
+		}
+
+		checksum.Recorded, checksum.Status = md5Sum, ChecksumOK
+		if md5Sum != EmptyString && !strings.HasPrefix(md5Sum, fmt.Sprintf("%d:", args.Version)) {
+			return fmt.Errorf("%s: the MD5SUM %s of %s::%s::%s is not of the checksum version %d, see --checksum-version",
+				args.Verify, md5Sum, checksum.Filename, checksum.ID, checksum.Author, args.Version)
+		}
+		if md5Sum != EmptyString && md5Sum != checksum.Checksum {
+			checksum.Status = ChecksumModified
+		}
+	}
+
+	return nil
+}
+
+func printChecksums(checksums []*ChangeSetChecksum) {
+	for _, checksum := range checksums {
+		identifier := fmt.Sprintf("%s::%s::%s", checksum.Filename, checksum.ID, checksum.Author)
+
+		switch checksum.Status {
+		case ChecksumUnsupported:
+			fmt.Println(red("Checksum: unsupported ->"), identifier, checksum.Error)
+		case ChecksumModified:
+			fmt.Println(red("Checksum: modified ->"), identifier, cyan(checksum.Checksum), red("recorded "+checksum.Recorded))
+		case ChecksumNotRun:
+			fmt.Println(yellow("Checksum: not run ->"), identifier, cyan(checksum.Checksum))
+		default:
+			fmt.Println(green("Checksum: ->"), identifier, cyan(checksum.Checksum))
+		}
+	}
+}
@@ -19,55 +19,55 @@ package changelog
 // ----------------------------------------------------------------
 
 const (
-	PrimaryTemplate = `<column name="{{ .Name }}" type="${type.{{ .Type }}}" remarks="{{ .Comment }}" autoIncrement="{{ .AutoIncrement }}">
+	PrimaryTemplate = `<column name="{{ xml .Name }}" type="${type.{{ .Type }}}" remarks="{{ xml .Comment }}" autoIncrement="{{ .AutoIncrement }}">
                 <constraints primaryKey="true" nullable="false"/>
             </column>`
-	BigintTemplate = `<column name="{{ .Name }}" type="${type.bigint}" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	BigintTemplate = `<column name="{{ xml .Name }}" type="${type.bigint}" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	TinyIntTemplate = `<column name="{{ .Name }}" type="${type.tinyint}" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	TinyIntTemplate = `<column name="{{ xml .Name }}" type="${type.tinyint}" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	SmallIntTemplate = `<column name="{{ .Name }}" type="${type.smallint}" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	SmallIntTemplate = `<column name="{{ xml .Name }}" type="${type.smallint}" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	MediumIntTemplate = `<column name="{{ .Name }}" type="${type.mediumint}" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	MediumIntTemplate = `<column name="{{ xml .Name }}" type="${type.mediumint}" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	IntTemplate = `<column name="{{ .Name }}" type="${type.int}" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	IntTemplate = `<column name="{{ xml .Name }}" type="${type.int}" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	FloatTemplate = `<column name="{{ .Name }}" type="${type.float}" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	FloatTemplate = `<column name="{{ xml .Name }}" type="${type.float}" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	DoubleColumn = `<column name="{{ .Name }}" type="${type.double}" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	DoubleColumn = `<column name="{{ xml .Name }}" type="${type.double}" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	DecimalTemplate = `<column name="{{ .Name }}" type="${type.decimal}({{ .Precision }}, {{ .Scale }})" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	DecimalTemplate = `<column name="{{ xml .Name }}" type="${type.decimal}({{ .Precision }}, {{ .Scale }})" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	CharTemplate = `<column name="{{ .Name }}" type="${type.char}({{ .TypeLength }})" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	CharTemplate = `<column name="{{ xml .Name }}" type="${type.char}({{ .TypeLength }})" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	VarcharTemplate = `<column name="{{ .Name }}" type="${type.varchar}({{ .TypeLength }})" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	VarcharTemplate = `<column name="{{ xml .Name }}" type="${type.varchar}({{ .TypeLength }})" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	TextTemplate = `<column name="{{ .Name }}" type="${type.text}" remarks="{{ .Comment }}">
+	TextTemplate = `<column name="{{ xml .Name }}" type="${type.text}" remarks="{{ xml .Comment }}">
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	DateTemplate = `<column name="{{ .Name }}" type="${type.date}" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	DateTemplate = `<column name="{{ xml .Name }}" type="${type.date}" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	TimeTemplate = `<column name="{{ .Name }}" type="${type.time}" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	TimeTemplate = `<column name="{{ xml .Name }}" type="${type.time}" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	DatetimeTemplate = `<column name="{{ .Name }}" type="${type.datetime}" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	DatetimeTemplate = `<column name="{{ xml .Name }}" type="${type.datetime}" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	TimestampTemplate = `<column name="{{ .Name }}" type="${type.timestamp}" remarks="{{ .Comment }}" {{ .DefaultValue }}>
+	TimestampTemplate = `<column name="{{ xml .Name }}" type="${type.timestamp}" remarks="{{ xml .Comment }}" {{ .DefaultValue }}>
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
-	UpdateTimestampTemplate = `<column name="{{ .Name }}" type="${type.timestamp}" remarks="{{ .Comment }}"
+	UpdateTimestampTemplate = `<column name="{{ xml .Name }}" type="${type.timestamp}" remarks="{{ xml .Comment }}"
                     defaultValueComputed="CURRENT_TIMESTAMP">
                 <constraints nullable="{{ .Nullable }}"/>
             </column>`
@@ -36,6 +36,26 @@ type Context struct {
 
 	Cwd  string
 	Path string
+	// ChangelogFile the path of the changelog of Table.
+	ChangelogFile string
+	// ChangeSet the id, author, context and labels of the changeSet of Table.
+	ChangeSet *ChangeSet
+
+	// Project the scaffold settings, set when rendering the scaffold.
+	Project *Project
+	// Driver the JDBC driver of Dialect, set when rendering the scaffold.
+	Driver *Driver
+	// Datasource the JDBC connection of Dialect, set when rendering the scaffold.
+	Datasource *Datasource
+	// MasterChangelog the master changelog of Dialect in the output layout, relative to the
+	// resources of the project, else to the project, e.g. liquibase/mysql/master.xml.
+	MasterChangelog string
+	// MasterLocation the Spring resource location of MasterChangelog, classpath: within the
+	// resources of the project, file: otherwise.
+	MasterLocation string
+	// Changelogs the changelogs of Version, relative to the rendered file, set for the
+	// version scope.
+	Changelogs []string
 
 	*Table
 }
@@ -97,11 +117,24 @@ type Column struct {
 
 type Index struct {
 	Name    string
+	Unique  bool
 	Columns []string
 }
 
 // ----------------------------------------------------------------
 
+// Element the rendered <column> element, whatever the type of the column.
+func (c *Column) Element() string {
+	return c.PrimaryColumn + c.BigintColumn +
+		c.TinyIntColumn + c.SmallIntColumn + c.MediumIntColumn + c.IntColumn +
+		c.FloatColumn + c.DoubleColumn + c.DecimalColumn +
+		c.CharColumn + c.VarcharColumn + c.TextColumn +
+		c.DateColumn + c.TimeColumn + c.DatetimeColumn +
+		c.TimestampColumn + c.UpdateTimestampColumn
+}
+
+// ----------------------------------------------------------------
+
 func (c *Column) testIsPrimaryColumn() bool {
 	return c.AutoIncrement
 }
@@ -17,26 +17,146 @@
 package changelog
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/photowey/liquigen/configs"
+	"github.com/photowey/liquigen/internal/cmd/database"
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/pkg/stringz"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	DefaultTimeout = 10 * time.Minute
 )
 
 // ----------------------------------------------------------------
 
 func OnDatabaseMode(args *Args) {
-	fmt.Printf("database mode: the Author: [%s]\n", args.Author)
-	fmt.Printf("database mode: the Email: [%s]\n", args.Email)
-	fmt.Printf("database mode: the Version: [%s]\n", args.Version)
+	loadDatabase(args)
+	confirm(args)
+
+	gen(args)
+}
+
+func loadDatabase(args *Args) {
+	populateDatabaseArgs(args)
+
+	if reverser, ok := database.AcquireReverser(args.Dialect); ok {
+		if err := reverseDatabase(reverser, args); err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				fmt.Println(red(fmt.Sprintf("database mode: introspection aborted: %v", err)))
+				os.Exit(1)
+			}
+
+			panic(err)
+		}
+
+		return
+	}
+
+	panic(fmt.Errorf("database mode: the dialect %s not supported", args.Dialect))
+}
 
-	fmt.Printf("database mode: the Host: [%s]\n", args.Host)
-	fmt.Printf("database mode: the Post: [%d]\n", args.Port)
-	fmt.Printf("database mode: the Username: [%s]\n", args.Username)
-	fmt.Printf("database mode: the Password: [%s]\n", args.Password)
-	fmt.Printf("database mode: the Dialect: [%s]\n", args.Dialect)
-	fmt.Printf("database mode: the Database name: [%s]\n", args.Database)
+func reverseDatabase(reverser database.Reverser, args *Args) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if args.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, args.Timeout)
+		defer cancel()
+	}
+
+	db, err := sql.Open(reverser.Driver(), reverser.DSN(&database.Args{
+		Host:     args.Host,
+		Port:     args.Port,
+		Username: args.Username,
+		Password: args.Password,
+		Database: args.Database,
+		Params:   args.Params,
+	}))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	db.SetMaxOpenConns(args.Concurrency)
+	db.SetMaxIdleConns(args.Concurrency)
+
+	if err = db.PingContext(ctx); err != nil {
+		return fmt.Errorf("database mode: connect to %s:%d failed: %w", args.Host, args.Port, err)
+	}
+
+	start := time.Now()
+	databasePtr, err := database.Reverse(ctx, reverser, db, args.Database, args.Concurrency, testIsTargetTable)
+	if err != nil {
+		return fmt.Errorf("database mode: %w", err)
+	}
+
+	fmt.Println(yellow("Database: introspected ->"),
+		cyan(fmt.Sprintf("%d tables in %s", len(databasePtr.Tables), time.Since(start).Round(time.Millisecond))))
+
+	args.Ast = &ast.Ast{
+		Database: databasePtr,
+	}
+
+	return nil
+}
+
+// populateDatabaseArgs falls back to the database section of liquigen.json
+// for every connection argument not given on the command line.
+func populateDatabaseArgs(args *Args) {
+	db := configs.ConfigDatabase()
+
+	if stringz.IsBlankString(args.Host) {
+		args.Host = db.Host
+	}
+	if args.Port <= 0 {
+		args.Port = db.Port
+	}
+	if stringz.IsBlankString(args.Username) {
+		args.Username = db.Username
+	}
+	if stringz.IsBlankString(args.Password) {
+		args.Password = db.Password
+	}
+	if stringz.IsBlankString(args.Database) {
+		args.Database = db.Database
+	}
+	if stringz.IsBlankString(args.Dialect) {
+		args.Dialect = db.Dialect
+	}
+	args.Params = populateDatabaseParams(db.Params, args.Params)
+	if args.Concurrency <= 0 {
+		args.Concurrency = db.Concurrency
+	}
+	if args.Concurrency <= 0 {
+		args.Concurrency = database.DefaultConcurrency
+	}
+}
 
-	fmt.Printf("database mode: the Format: [%s]\n", args.Format)
+// populateDatabaseParams merges the connection parameters, the overrides winning over the defaults.
+func populateDatabaseParams(defaults, overrides map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return overrides
+	}
 
-	fmt.Printf("database mode: the sqlFile: [%s]\n", args.SQLFile)
+	params := make(map[string]string, len(defaults)+len(overrides))
+	for key, value := range defaults {
+		params[key] = value
+	}
+	for key, value := range overrides {
+		params[key] = value
+	}
 
-	fmt.Printf(red("database mode: unsupported now"))
+	return params
 }
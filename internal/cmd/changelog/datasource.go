@@ -0,0 +1,99 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/photowey/liquigen/configs"
+	"github.com/photowey/liquigen/pkg/stringz"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	DefaultDatasourceHost     = "127.0.0.1"
+	DefaultDatasourceDatabase = "liquibase"
+	DefaultDatasourceUsername = "root"
+)
+
+// _jdbcDialects the JDBC url scheme, driver class and default port of every dialect.
+var _jdbcDialects = map[string]struct {
+	scheme      string
+	driverClass string
+	port        int
+}{
+	"mysql":    {scheme: "mysql", driverClass: "com.mysql.cj.jdbc.Driver", port: 3306},
+	"postgres": {scheme: "postgresql", driverClass: "org.postgresql.Driver", port: 5432},
+	"sqlite":   {scheme: "sqlite", driverClass: "org.sqlite.JDBC"},
+}
+
+// ----------------------------------------------------------------
+
+// Datasource the JDBC connection of the scaffold, without the password.
+type Datasource struct {
+	URL         string
+	DriverClass string
+	Username    string
+}
+
+// newDatasource the datasource of args.Dialect: the connection arguments, then the database
+// section of liquigen.json, then the defaults; the port of liquigen.json is used only when
+// its dialect is args.Dialect.
+func newDatasource(args *Args) *Datasource {
+	db := configs.ConfigDatabase()
+	dialect := strings.ToLower(args.Dialect)
+	jdbc, ok := _jdbcDialects[dialect]
+	if !ok {
+		return &Datasource{}
+	}
+
+	pick := func(values ...string) string {
+		for _, value := range values {
+			if stringz.IsNotBlankString(value) {
+				return value
+			}
+		}
+
+		return EmptyString
+	}
+
+	port := jdbc.port
+	if strings.EqualFold(db.Dialect, dialect) && db.Port > 0 {
+		port = db.Port
+	}
+	if args.Port > 0 {
+		port = args.Port
+	}
+
+	host := pick(args.Host, db.Host, DefaultDatasourceHost)
+	database := pick(args.Database, db.Database, DefaultDatasourceDatabase)
+
+	datasource := &Datasource{
+		DriverClass: jdbc.driverClass,
+		Username:    pick(args.Username, db.Username, DefaultDatasourceUsername),
+	}
+
+	if port == 0 {
+		datasource.URL = fmt.Sprintf("jdbc:%s:%s.db", jdbc.scheme, database)
+	} else {
+		datasource.URL = fmt.Sprintf("jdbc:%s://%s:%d/%s", jdbc.scheme, host, port, database)
+	}
+
+	return datasource
+}
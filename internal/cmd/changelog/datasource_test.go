@@ -0,0 +1,58 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewDatasource(t *testing.T) {
+	tests := []struct {
+		name string
+		args *Args
+		want *Datasource
+	}{
+		{
+			name: "test mysql",
+			args: &Args{Dialect: "mysql", Host: "db", Database: "company", Username: "app"},
+			want: &Datasource{URL: "jdbc:mysql://db:3306/company", DriverClass: "com.mysql.cj.jdbc.Driver", Username: "app"},
+		},
+		{
+			name: "test postgres defaults",
+			args: &Args{Dialect: "postgres"},
+			want: &Datasource{URL: "jdbc:postgresql://127.0.0.1:5432/liquibase", DriverClass: "org.postgresql.Driver", Username: "root"},
+		},
+		{
+			name: "test sqlite",
+			args: &Args{Dialect: "sqlite", Database: "company"},
+			want: &Datasource{URL: "jdbc:sqlite:company.db", DriverClass: "org.sqlite.JDBC", Username: "root"},
+		},
+		{
+			name: "test unknown dialect",
+			args: &Args{Dialect: "oracle"},
+			want: &Datasource{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newDatasource(tt.args); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newDatasource() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
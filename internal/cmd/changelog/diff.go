@@ -0,0 +1,243 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/internal/cmd/database/ast/parser/mysql"
+	"github.com/photowey/liquigen/internal/cmd/database/diff"
+)
+
+// ----------------------------------------------------------------
+
+var changeTemplates = map[diff.Kind]string{
+	diff.CreateTable:           CreateTableChangeTemplate,
+	diff.DropTable:             DropTableChangeTemplate,
+	diff.SetTableRemarks:       SetTableRemarksChangeTemplate,
+	diff.AddColumn:             AddColumnChangeTemplate,
+	diff.DropColumn:            DropColumnChangeTemplate,
+	diff.ModifyDataType:        ModifyDataTypeChangeTemplate,
+	diff.AddNotNullConstraint:  AddNotNullChangeTemplate,
+	diff.DropNotNullConstraint: DropNotNullChangeTemplate,
+	diff.AddDefaultValue:       AddDefaultValueChangeTemplate,
+	diff.DropDefaultValue:      DropDefaultValueChangeTemplate,
+	diff.SetColumnRemarks:      SetColumnRemarksChangeTemplate,
+	diff.CreateIndex:           CreateIndexChangeTemplate,
+	diff.DropIndex:             DropIndexChangeTemplate,
+	diff.RenameTable:           RenameTableChangeTemplate,
+	diff.RenameColumn:          RenameColumnChangeTemplate,
+}
+
+// ----------------------------------------------------------------
+
+type DiffContext struct {
+	Author  string
+	Version string
+	Date    string
+	Dialect string
+
+	Table      string
+	ChangeSets []*ChangeSet
+}
+
+type ChangeSet struct {
+	ID      string
+	Author  string
+	Context string
+	Labels  string
+	// Preconditions the rendered <preConditions> element, blank when none.
+	Preconditions string
+	// ObjectQuotingStrategy the objectQuotingStrategy of the changeSet, blank for the default.
+	ObjectQuotingStrategy string
+
+	Comment string
+	Change  string
+}
+
+// ChangeContext the data of one change template.
+type ChangeContext struct {
+	Table    string
+	Remarks  string
+	DataType string
+
+	Column  *Column
+	Columns []*Column
+	Index   *ast.Index
+
+	// OldTable the previous name of a renamed table.
+	OldTable string
+	// OldColumn the previous name of a renamed column.
+	OldColumn string
+}
+
+// ----------------------------------------------------------------
+
+// writeDiff plans one changelog per changed table, laid out for args.Version.
+func writeDiff(args *Args, d *diff.Diff, output *Output) error {
+	date := generationDate(args).Format(DatetimeLayout)
+
+	for _, table := range d.Tables {
+		if testIsNotTargetTable(table.Name) {
+			continue
+		}
+
+		content, err := renderTableDiff(args, date, table)
+		if err != nil {
+			return err
+		}
+
+		output.AddChangelog(outputLayout(args).TableChangelogFile(args, table.Name, date), []byte(content), 0o644)
+	}
+
+	return nil
+}
+
+func renderTableDiff(args *Args, date string, table *diff.TableDiff) (string, error) {
+	ctx := &DiffContext{
+		Author:  args.Author,
+		Version: args.Version,
+		Date:    date,
+		Dialect: args.Dialect,
+		Table:   table.Name,
+	}
+
+	for i, change := range table.Changes {
+		content, err := renderChange(args, change)
+		if err != nil {
+			return EmptyString, err
+		}
+
+		policy := changeSetPolicy(args)
+		changeSet := policy.ChangeSet(args, table.Name, date, i+1)
+		changeSet.Preconditions = policy.preconditions(changePreconditions(change))
+		identifierPolicy(args).quote(args, changeSet, changeIdentifiers(change))
+		changeSet.Comment = describeChange(change)
+		changeSet.Change = content
+
+		ctx.ChangeSets = append(ctx.ChangeSets, changeSet)
+	}
+
+	bytes, err := parseDiff(ctx, ChangeLogTemplate)
+	if err != nil {
+		return EmptyString, err
+	}
+
+	return replaceSpace(string(bytes)) + "\n", nil
+}
+
+func renderChange(args *Args, change *diff.Change) (string, error) {
+	tmpl, ok := changeTemplates[change.Kind]
+	if !ok {
+		return EmptyString, fmt.Errorf("liquigen: unsupported change %s", change.Kind)
+	}
+
+	ctx := &ChangeContext{
+		Table:   change.Table.Name,
+		Remarks: change.Table.Comment,
+		Index:   change.Index,
+	}
+
+	if change.Column != nil {
+		ctx.Column = templateColumn(args, change.Column)
+		ctx.DataType = columnDataType(ctx.Column)
+		// MySQL sets the remarks by MODIFY COLUMN, which keeps the not null constraint only
+		// given with the data type.
+		if change.Kind == diff.SetColumnRemarks && change.Column.NotNull && args.Dialect == mysql.Dialect {
+			ctx.DataType += " NOT NULL"
+		}
+	}
+
+	if change.FromTable != nil {
+		ctx.OldTable = change.FromTable.Name
+	}
+	if change.Kind == diff.RenameColumn {
+		ctx.OldColumn = change.From.Name
+	}
+
+	if change.Kind == diff.CreateTable {
+		for _, column := range change.Table.Columns {
+			ctx.Columns = append(ctx.Columns, templateColumn(args, column))
+		}
+	}
+
+	bytes, err := parseChange(ctx, tmpl)
+	if err != nil {
+		return EmptyString, err
+	}
+
+	return string(bytes), nil
+}
+
+func describeChange(change *diff.Change) string {
+	table := change.Table.Name
+
+	switch change.Kind {
+	case diff.CreateTable:
+		return fmt.Sprintf("Create the table: %s", table)
+	case diff.DropTable:
+		return fmt.Sprintf("Drop the table: %s", table)
+	case diff.SetTableRemarks:
+		return fmt.Sprintf("Set the remarks of the table: %s", table)
+	case diff.CreateIndex:
+		return fmt.Sprintf("Create the index: %s.%s", table, change.Index.Name)
+	case diff.DropIndex:
+		return fmt.Sprintf("Drop the index: %s.%s", table, change.Index.Name)
+	case diff.AddColumn:
+		return fmt.Sprintf("Add the column: %s.%s", table, change.Column.Name)
+	case diff.DropColumn:
+		return fmt.Sprintf("Drop the column: %s.%s", table, change.Column.Name)
+	case diff.ModifyDataType:
+		return fmt.Sprintf("Modify the data type of the column: %s.%s %s -> %s",
+			table, change.Column.Name, diff.DataType(change.From), diff.DataType(change.Column))
+	case diff.AddNotNullConstraint:
+		return fmt.Sprintf("Add the not null constraint of the column: %s.%s", table, change.Column.Name)
+	case diff.DropNotNullConstraint:
+		return fmt.Sprintf("Drop the not null constraint of the column: %s.%s", table, change.Column.Name)
+	case diff.AddDefaultValue:
+		return fmt.Sprintf("Set the default value of the column: %s.%s", table, change.Column.Name)
+	case diff.DropDefaultValue:
+		return fmt.Sprintf("Drop the default value of the column: %s.%s", table, change.Column.Name)
+	case diff.SetColumnRemarks:
+		return fmt.Sprintf("Set the remarks of the column: %s.%s", table, change.Column.Name)
+	case diff.RenameTable:
+		return fmt.Sprintf("Rename the table: %s -> %s", change.FromTable.Name, table)
+	case diff.RenameColumn:
+		return fmt.Sprintf("Rename the column: %s.%s -> %s.%s", table, change.From.Name, table, change.Column.Name)
+	default:
+		return fmt.Sprintf("%s: %s", change.Kind, table)
+	}
+}
+
+// ----------------------------------------------------------------
+
+func templateColumn(args *Args, column *ast.Column) *Column {
+	return populateTemplateColumn(args, populateInitColumn(column))
+}
+
+// columnDataType the Liquibase data type of the column, e.g. ${type.varchar}(32).
+func columnDataType(column *Column) string {
+	switch {
+	case column.testIsDecimalColumn():
+		return fmt.Sprintf("${type.%s}(%d, %d)", column.Type, column.Precision, column.Scale)
+	case column.testIsCharColumn(), column.testIsVarcharColumn():
+		return fmt.Sprintf("${type.%s}(%d)", column.Type, column.TypeLength)
+	default:
+		return fmt.Sprintf("${type.%s}", column.Type)
+	}
+}
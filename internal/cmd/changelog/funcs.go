@@ -0,0 +1,265 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/photowey/liquigen/internal/cmd/database/types"
+	"github.com/photowey/liquigen/pkg/alphabet"
+)
+
+// ----------------------------------------------------------------
+
+// _javaTypes the Java type of each column type, for the templates of entities or DTOs.
+var _javaTypes = map[string]string{
+	types.BIGINT:    "Long",
+	types.TINYINT:   "Integer",
+	types.SMALLINT:  "Integer",
+	types.MEDIUMINT: "Integer",
+	types.INT:       "Integer",
+	types.FLOAT:     "Float",
+	types.DOUBLE:    "Double",
+	types.DECIMAL:   "java.math.BigDecimal",
+	types.CHAR:      "String",
+	types.VARCHAR:   "String",
+	types.TEXT:      "String",
+	types.DATE:      "java.time.LocalDate",
+	types.TIME:      "java.time.LocalTime",
+	types.DATETIME:  "java.time.LocalDateTime",
+	types.TIMESTAMP: "java.time.LocalDateTime",
+}
+
+var _xmlEscaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	`"`, "&quot;",
+	"'", "&apos;",
+)
+
+var _yamlEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+)
+
+// ----------------------------------------------------------------
+
+// FuncMap the functions available to every template, the embedded ones and the overrides:
+//
+//	camelCase, pascalCase, snakeCase   userName, UserName, user_name
+//	snake2Camel, snake2Pascal          user_name -> userName, UserName
+//	cleanTableComment COMMENT TABLE    the comment without "(TABLE)"
+//	upper, lower, trim                 the strings functions of the same name
+//	replace OLD NEW S, join SEP LIST   strings.ReplaceAll and strings.Join
+//	xml S, yaml S                      S escaped for an XML attribute, a double-quoted YAML scalar
+//	plural S, singular S               the English plural and singular of a noun
+//	dataType COLUMN                    the Liquibase type of the column, e.g. ${type.varchar}(32)
+//	javaType COLUMN|TYPE               the Java type of the column, e.g. Long
+//	formatDate LAYOUT DATE             DATE(a time, or a yyyyMMdd string such as .Date) in the Go LAYOUT
+//	indent N S                         S with every line indented by N spaces
+//	default DEFAULT VALUE              VALUE, or DEFAULT when VALUE is empty
+//	hasIndex TABLE COLUMN              whether an index of the table covers the column
+//	isPrimary COLUMN                   whether the column is the primary key
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"camelCase":         alphabet.CamelCase,
+		"pascalCase":        alphabet.PascalCase,
+		"snakeCase":         alphabet.SnakeCase,
+		"snake2Camel":       alphabet.Snake2Camel,
+		"snake2Pascal":      alphabet.Snake2Pascal,
+		"cleanTableComment": alphabet.CleanTableComment,
+		"upper":             strings.ToUpper,
+		"lower":             strings.ToLower,
+		"trim":              strings.TrimSpace,
+		"replace":           replace,
+		"join":              join,
+		"xml":               escapeXML,
+		"yaml":              escapeYAML,
+		"plural":            plural,
+		"singular":          singular,
+		"dataType":          columnDataType,
+		"javaType":          javaType,
+		"formatDate":        formatDate,
+		"indent":            indent,
+		"default":           defaultValue,
+		"hasIndex":          hasIndex,
+		"isPrimary":         isPrimary,
+	}
+}
+
+// newTemplate parses tmpl with the functions of FuncMap.
+func newTemplate(tmpl string) (*template.Template, error) {
+	return template.New(EmptyString).Funcs(FuncMap()).Parse(tmpl)
+}
+
+// ----------------------------------------------------------------
+
+func replace(from, to, src string) string {
+	return strings.ReplaceAll(src, from, to)
+}
+
+func join(sep string, list []string) string {
+	return strings.Join(list, sep)
+}
+
+func escapeXML(src string) string {
+	return _xmlEscaper.Replace(src)
+}
+
+func escapeYAML(src string) string {
+	return _yamlEscaper.Replace(src)
+}
+
+// ----------------------------------------------------------------
+
+func plural(noun string) string {
+	lower := strings.ToLower(noun)
+	switch {
+	case noun == EmptyString:
+		return noun
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "z"),
+		strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return noun + "es"
+	case strings.HasSuffix(lower, "y") && !endsWithVowel(lower[:len(lower)-1]):
+		return noun[:len(noun)-1] + "ies"
+	default:
+		return noun + "s"
+	}
+}
+
+func singular(noun string) string {
+	lower := strings.ToLower(noun)
+	switch {
+	case strings.HasSuffix(lower, "ies") && len(noun) > 3:
+		return noun[:len(noun)-3] + "y"
+	case strings.HasSuffix(lower, "sses"), strings.HasSuffix(lower, "xes"), strings.HasSuffix(lower, "zes"),
+		strings.HasSuffix(lower, "ches"), strings.HasSuffix(lower, "shes"):
+		return noun[:len(noun)-2]
+	case strings.HasSuffix(lower, "ss"), strings.HasSuffix(lower, "us"):
+		return noun
+	case strings.HasSuffix(lower, "s"):
+		return noun[:len(noun)-1]
+	default:
+		return noun
+	}
+}
+
+func endsWithVowel(src string) bool {
+	return src != EmptyString && strings.ContainsAny(src[len(src)-1:], "aeiou")
+}
+
+// ----------------------------------------------------------------
+
+func javaType(column any) (string, error) {
+	var name string
+	switch value := column.(type) {
+	case *Column:
+		name = value.Type
+	case string:
+		name = value
+	default:
+		return EmptyString, fmt.Errorf("javaType: want a column or a type, got %T", column)
+	}
+
+	if javaType, ok := _javaTypes[strings.ToLower(name)]; ok {
+		return javaType, nil
+	}
+
+	return "Object", nil
+}
+
+func formatDate(layout string, date any) (string, error) {
+	switch value := date.(type) {
+	case time.Time:
+		return value.Format(layout), nil
+	case string:
+		parsed, err := time.Parse(DatetimeLayout, value)
+		if err != nil {
+			return EmptyString, fmt.Errorf("formatDate: %v", err)
+		}
+
+		return parsed.Format(layout), nil
+	default:
+		return EmptyString, fmt.Errorf("formatDate: want a time or a %s date, got %T", DatetimeLayout, date)
+	}
+}
+
+func indent(spaces int, src string) string {
+	padding := strings.Repeat(" ", spaces)
+	lines := strings.Split(src, "\n")
+	for i, line := range lines {
+		if line != EmptyString {
+			lines[i] = padding + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// defaultValue value, or def when value is the zero value of its type, a nil or an empty
+// string, slice or map.
+func defaultValue(def any, value any) any {
+	if value == nil {
+		return def
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String:
+		if v.Len() == 0 {
+			return def
+		}
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return def
+		}
+	default:
+		if v.IsZero() {
+			return def
+		}
+	}
+
+	return value
+}
+
+func hasIndex(table *Table, column string) bool {
+	if table == nil {
+		return false
+	}
+
+	for _, index := range table.Indexes {
+		for _, name := range index.Columns {
+			if strings.EqualFold(name, column) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isPrimary(column *Column) bool {
+	return column != nil && column.testIsPrimaryColumn()
+}
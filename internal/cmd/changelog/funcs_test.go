@@ -0,0 +1,77 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"testing"
+)
+
+func TestFuncMap(t *testing.T) {
+	ctx := NewContext()
+	ctx.Date = "20241027"
+	ctx.Table = &Table{
+		Name:    "order_item",
+		Comment: `Items(order_item) of "orders" & <carts>`,
+		Columns: []*Column{
+			{Name: "id", Type: "bigint", AutoIncrement: true},
+			{Name: "order_id", Type: "bigint"},
+			{Name: "title", Type: "varchar", TypeLength: 64},
+			{Name: "price", Type: "decimal", Precision: 10, Scale: 2},
+		},
+		Indexes: []*Index{{Name: "idx_order_id", Columns: []string{"order_id"}}},
+	}
+
+	tests := []struct {
+		name    string
+		tmpl    string
+		want    string
+		wantErr bool
+	}{
+		{name: "case", tmpl: `{{ snake2Pascal .Table.Name }} {{ snake2Camel .Table.Name }} {{ snakeCase "OrderItem" }} {{ camelCase "OrderItem" }} {{ pascalCase "orderItem" }}`,
+			want: "OrderItem orderItem order_item orderItem OrderItem"},
+		{name: "clean table comment", tmpl: `{{ cleanTableComment .Table.Comment .Table.Name | xml }}`,
+			want: "Items of &quot;orders&quot; &amp; &lt;carts&gt;"},
+		{name: "yaml", tmpl: `"{{ yaml "a \"b\"\nc" }}"`, want: `"a \"b\"\nc"`},
+		{name: "plural", tmpl: `{{ plural "order" }} {{ plural "category" }} {{ plural "key" }} {{ plural "box" }} {{ plural "address" }}`,
+			want: "orders categories keys boxes addresses"},
+		{name: "singular", tmpl: `{{ singular "orders" }} {{ singular "categories" }} {{ singular "boxes" }} {{ singular "status" }}`,
+			want: "order category box status"},
+		{name: "types", tmpl: `{{ range .Table.Columns }}{{ .Name }}:{{ dataType . }}:{{ javaType . }};{{ end }}`,
+			want: "id:${type.bigint}:Long;order_id:${type.bigint}:Long;title:${type.varchar}(64):String;price:${type.decimal}(10, 2):java.math.BigDecimal;"},
+		{name: "java type of a type", tmpl: `{{ javaType "geometry" }}`, want: "Object"},
+		{name: "format date", tmpl: `{{ formatDate "2006-01-02" .Date }}`, want: "2024-10-27"},
+		{name: "format invalid date", tmpl: `{{ formatDate "2006-01-02" "yesterday" }}`, wantErr: true},
+		{name: "indent", tmpl: `{{ indent 4 "a\n\nb" }}`, want: "    a\n\n    b"},
+		{name: "default", tmpl: `{{ default "none" .Version }}|{{ .Date | default "none" }}|{{ default 3 0 }}`,
+			want: "none|20241027|3"},
+		{name: "join", tmpl: `{{ join ", " (index .Table.Indexes 0).Columns }}`, want: "order_id"},
+		{name: "replace", tmpl: `{{ replace "_" "-" .Table.Name | upper }}`, want: "ORDER-ITEM"},
+		{name: "index and primary", tmpl: `{{ range .Table.Columns }}{{ .Name }}:{{ isPrimary . }}:{{ hasIndex $.Table .Name }};{{ end }}`,
+			want: "id:true:false;order_id:false:true;title:false:false;price:false:false;"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTmpl(ctx, tt.tmpl)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseTmpl() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != tt.want {
+				t.Errorf("parseTmpl() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
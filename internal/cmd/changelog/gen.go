@@ -18,13 +18,14 @@ package changelog
 
 import (
 	"fmt"
+	"os"
 	"strings"
-	"time"
 
 	"github.com/photowey/liquigen/configs"
 	"github.com/photowey/liquigen/internal/cmd/database/ast"
 	"github.com/photowey/liquigen/internal/cmd/database/ast/parser/mysql"
 	"github.com/photowey/liquigen/internal/cmd/database/ast/parser/postgres"
+	"github.com/photowey/liquigen/internal/cmd/database/diff"
 	"github.com/photowey/liquigen/pkg/stringz"
 )
 
@@ -32,31 +33,111 @@ const (
 	EmptyString = ""
 )
 
+// gen generates the project and the changelogs of every table, the first time; then
+// only the changeSets of the differences with the schema recorded in the state file,
+// leaving the changelogs already generated untouched.
 func gen(args *Args) {
-	doGenerate(args)
+	sortIndexes(args.Ast.Database)
 
-	cwd := args.Cwd
-	fmt.Println(yellow("File: generated ->"), cyan("$pwd: "+cwd))
+	state, err := readState(args)
+	if err != nil {
+		panic(err)
+	}
+
+	var written bool
+	if schema, ok := state.Schemas[args.Dialect]; ok {
+		written = genIncrement(args, schema)
+	} else {
+		if written, err = doGenerate(args); err != nil {
+			panic(err)
+		}
+
+		if testIsWriteMode(args) {
+			fmt.Println(yellow("File: generated ->"), cyan("$pwd: "+args.Path))
+		}
+	}
+
+	if !written || !testIsWriteMode(args) {
+		return
+	}
+
+	state.Record(args.Dialect, args.Version, targetDatabase(args.Ast.Database))
+	if err = writeState(args, state); err != nil {
+		panic(err)
+	}
 }
 
-func doGenerate(args *Args) {
-	db := configs.ConfigDatabase()
-	excludes := db.Excludes
-	includes := db.Includes
+// flushChangelogs flushes the output and reports whether the changelogs were all written,
+// the state being recorded only then.
+func flushChangelogs(args *Args, output *Output) bool {
+	written := flushOutput(args, output)
+	if !written && testIsWriteMode(args) {
+		fmt.Println(yellow("State: the changelogs were not all written, the state is left unchanged"))
+	}
+
+	return written
+}
+
+// testIsWriteMode reports whether the generated files are written, neither a dry run nor
+// streamed to stdout.
+func testIsWriteMode(args *Args) bool {
+	return !args.DryRun && args.Stdout == nil
+}
+
+// genIncrement writes the changeSets turning the recorded schema into args.Ast into the
+// changelogs of args.Version, and reports whether they were all written.
+func genIncrement(args *Args, schema *SchemaState) bool {
+	if stringz.ArrayContains(schema.Versions, args.Version) {
+		fmt.Println(red(fmt.Sprintf("State: the version %s already generated, please use a new version", args.Version)))
+		os.Exit(1)
+	}
+
+	current := targetDatabase(args.Ast.Database)
+
+	renames := diff.NewRenames()
+	confirmRenames(renames, diff.Candidates(schema.Database, current, renames))
+
+	d := diff.Compare(schema.Database, current, renames)
+	if d.Empty() {
+		fmt.Println(green(fmt.Sprintf("State: no differences since the version %s", schema.Versions[len(schema.Versions)-1])))
+
+		return false
+	}
+
+	reportDiff(diff.Analyze(d))
+
+	output := NewOutput(args)
+	if err := writeDiff(args, d, output); err != nil {
+		panic(err)
+	}
+	if err := completeOutput(args, nil, nil, output); err != nil {
+		panic(err)
+	}
+
+	return flushChangelogs(args, output)
+}
 
+// doGenerate writes the project and the changelogs of every table, and reports whether the
+// changelogs were all written.
+func doGenerate(args *Args) (bool, error) {
 	astz := args.Ast
 	databasePtr := astz.Database
 
-	err := writeNormal(args)
+	output := NewOutput(args)
+	templates := templatesOf(args)
+	manifest, err := readManifest(templates)
 	if err != nil {
-		panic(err)
+		return false, err
 	}
 
-	for _, tablePtr := range databasePtr.Tables {
-		if len(excludes) > 0 && stringz.ArrayContains(excludes, tablePtr.Name) {
-			continue
+	if !testIsChangelogsScaffold(args) {
+		if err = writeNormal(args, templates, manifest, output); err != nil {
+			return false, err
 		}
-		if len(includes) > 0 && stringz.ArrayNotContains(includes, tablePtr.Name) {
+	}
+
+	for _, tablePtr := range databasePtr.Tables {
+		if testIsNotTargetTable(tablePtr.Name) {
 			continue
 		}
 
@@ -65,15 +146,67 @@ func doGenerate(args *Args) {
 
 		changelog(args, ctx)
 
-		if err = write(ctx); err != nil {
-			fmt.Printf("liquigen: write tmpl failed, err:%v\n", err)
-			return
+		if err = write(ctx, args, templates, manifest, output); err != nil {
+			return false, fmt.Errorf("write the changelog of %s failed: %w", tablePtr.Name, err)
+		}
+	}
+
+	if err = completeOutput(args, templates, manifest, output); err != nil {
+		return false, err
+	}
+
+	return flushChangelogs(args, output), nil
+}
+
+// completeOutput plans, once the changelogs are planned into output, the version files of
+// the manifest, the baseline sync artefact and, for the changelogs scaffold, the update of
+// the master changelog; the templates of args and their manifest when nil.
+func completeOutput(args *Args, templates *Templates, manifest *Manifest, output *Output) (err error) {
+	if templates == nil {
+		templates = templatesOf(args)
+	}
+	if manifest == nil {
+		if manifest, err = readManifest(templates); err != nil {
+			return err
+		}
+	}
+
+	if err = writeVersion(args, templates, manifest, output); err != nil {
+		return err
+	}
+	if args.Baseline != BaselineNone {
+		if err = writeBaseline(args, output); err != nil {
+			return err
 		}
 	}
+	if testIsChangelogsScaffold(args) {
+		return updateMaster(args, templates, output)
+	}
+
+	return nil
+}
+
+func testIsTargetTable(name string) bool {
+	db := configs.ConfigDatabase()
+	excludes := db.Excludes
+	includes := db.Includes
+
+	if len(excludes) > 0 && stringz.ArrayContains(excludes, name) {
+		return false
+	}
+	if len(includes) > 0 && stringz.ArrayNotContains(includes, name) {
+		return false
+	}
+
+	return true
+}
+
+func testIsNotTargetTable(name string) bool {
+	return !testIsTargetTable(name)
 }
 
 func initCtx(args *Args, astTable *ast.Table) *Context {
-	now := time.Now()
+	now := generationDate(args)
 	layout := DatetimeLayout
 
 	var columns []*Column
@@ -85,12 +218,26 @@ func initCtx(args *Args, astTable *ast.Table) *Context {
 		columns = append(columns, c)
 	}
 
+	indexes := []*Index{}
+	for _, index := range astTable.Indexes {
+		indexes = append(indexes, &Index{Name: index.Name, Unique: index.Unique, Columns: index.Columns})
+	}
+
 	table := &Table{
 		Name:    astTable.Name,
 		Comment: astTable.Comment,
 		Columns: columns,
-		Indexes: []*Index{},
+		Indexes: indexes,
+	}
+
+	policy := changeSetPolicy(args)
+	changeSet := policy.ChangeSet(args, astTable.Name, now.Format(layout), 1)
+	changeSet.Preconditions = policy.preconditions(tablePreconditions(astTable.Name))
+	identifiers := tableIdentifiers(astTable)
+	for _, index := range astTable.Indexes {
+		identifiers = append(identifiers, &Identifier{Kind: IdentifierIndex, Name: index.Name})
 	}
+	identifierPolicy(args).quote(args, changeSet, identifiers)
 
 	return &Context{
 		Author:  args.Author,
@@ -104,6 +251,9 @@ func initCtx(args *Args, astTable *ast.Table) *Context {
 		Cwd:  args.Cwd,
 		Path: args.Path,
 
+		ChangelogFile: outputLayout(args).TableChangelogFile(args, astTable.Name, now.Format(layout)),
+		ChangeSet:     changeSet,
+
 		Table: table,
 	}
 }
@@ -112,11 +262,11 @@ func populateTemplateColumn(args *Args, tmp *Column) *Column {
 	dv := stringz.RemoveQuotes(tmp.DefaultValue)
 	if stringz.IsNotBlankString(dv) {
 		if tmp.testIsTimestampColumn() || tmp.testIsUpdateTimestamp() {
-			dv = fmt.Sprintf("defaultValueComputed=\"%s\"", dv)
+			dv = fmt.Sprintf("defaultValueComputed=\"%s\"", escapeXML(dv))
 		} else if tmp.testIsDatetimeColumn() {
-			dv = fmt.Sprintf("defaultValueDate=\"%s\"", dv)
+			dv = fmt.Sprintf("defaultValueDate=\"%s\"", escapeXML(dv))
 		} else {
-			dv = fmt.Sprintf("defaultValue=\"%s\"", dv)
+			dv = fmt.Sprintf("defaultValue=\"%s\"", escapeXML(dv))
 		}
 	}
 
@@ -330,7 +480,7 @@ func datetimeColumn(column *Column) string {
 }
 
 func timestampColumn(column *Column) string {
-	if column.testIsNotTimestampColumn() {
+	if column.testIsNotTimestampColumn() || column.testIsUpdateTimestamp() {
 		return EmptyString
 	}
 	bytes, _ := parseField(column, TimestampTemplate)
@@ -0,0 +1,314 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/photowey/liquigen/configs"
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/internal/cmd/database/diff"
+	"github.com/photowey/liquigen/internal/cmd/database/identifier"
+)
+
+// ----------------------------------------------------------------
+
+// Quoting how the changeSets naming an identifier reserved by a target dialect are quoted.
+type Quoting string
+
+const (
+	// QuotingReserved quotes the reserved words only, objectQuotingStrategy="QUOTE_ONLY_RESERVED_WORDS";
+	// the changeSets naming a word reserved by liquigen.json only, unknown to Liquibase, quote
+	// every object instead.
+	QuotingReserved Quoting = "reserved"
+	// QuotingAll quotes every object, objectQuotingStrategy="QUOTE_ALL_OBJECTS"; the names
+	// become case-sensitive on Postgres and Oracle.
+	QuotingAll Quoting = "all"
+	// QuotingNone leaves the changeSets untouched, the reserved identifiers being reported only.
+	QuotingNone Quoting = "none"
+)
+
+const (
+	IdentifierTable  = "table"
+	IdentifierColumn = "column"
+	IdentifierIndex  = "index"
+)
+
+var (
+	_quotings          = []Quoting{QuotingReserved, QuotingAll, QuotingNone}
+	_quotingStrategies = map[Quoting]string{
+		QuotingReserved: "QUOTE_ONLY_RESERVED_WORDS",
+		QuotingAll:      "QUOTE_ALL_OBJECTS",
+	}
+)
+
+// ----------------------------------------------------------------
+
+// ParseQuoting parses the quoting of the reserved identifiers, reserved by default.
+func ParseQuoting(name string) (Quoting, error) {
+	if name == EmptyString {
+		return QuotingReserved, nil
+	}
+
+	for _, quoting := range _quotings {
+		if strings.EqualFold(string(quoting), name) {
+			return quoting, nil
+		}
+	}
+
+	return QuotingReserved, fmt.Errorf("unknown quoting %q, want reserved, all or none", name)
+}
+
+// Strategy the objectQuotingStrategy of the quoting, blank for none.
+func (q Quoting) Strategy() string {
+	return _quotingStrategies[q]
+}
+
+// ----------------------------------------------------------------
+
+// IdentifierPolicy how the generated changeSets deal with the identifiers reserved, or too
+// long, on the target dialects; the issues found are recorded for the generation report.
+type IdentifierPolicy struct {
+	// Targets the dialects the changelogs run on, the generated dialect when empty.
+	Targets []string
+	// Quoting how the changeSets naming a reserved identifier are quoted.
+	Quoting Quoting
+	// MaxLength the maximum length of the names in bytes, 0 for the shortest limit of Targets.
+	MaxLength int
+	// ReservedWords the names reserved on top of those of Targets, e.g. type.
+	ReservedWords []string
+
+	issues []*IdentifierIssue
+}
+
+// Identifier a table, column or index name of a changeSet.
+type Identifier struct {
+	// Kind table, column or index.
+	Kind string
+	Name string
+}
+
+// IdentifierIssue an identifier of a generated changeSet reserved, or too long, on the target
+// dialects, and what the generator did about it.
+type IdentifierIssue struct {
+	ChangeSet string
+	Identifier
+	// Reserved the dialects reserving the name, identifier.Configured for the configured words.
+	Reserved []string
+	// Length the length of the name in bytes when over Limit, 0 otherwise.
+	Length int
+	Limit  int
+	// LimitOf the dialect of Limit, identifier.Configured for the configured one.
+	LimitOf string
+	// Strategy the objectQuotingStrategy set on the changeSet, blank when untouched.
+	Strategy string
+}
+
+func DefaultIdentifierPolicy() *IdentifierPolicy {
+	return &IdentifierPolicy{Quoting: QuotingReserved}
+}
+
+// ConfigIdentifierPolicy the policy of liquigen.json: the targets, the identifier length and
+// the reserved words of the lint section, the quoting of the changeSet section; targets and
+// quoting, when set, take precedence.
+func ConfigIdentifierPolicy(targets []string, quoting string) (*IdentifierPolicy, error) {
+	conf := configs.ConfigLint()
+	policy := DefaultIdentifierPolicy()
+
+	policy.Targets = conf.Dialects
+	if len(targets) > 0 {
+		policy.Targets = targets
+	}
+	for _, dialect := range policy.Targets {
+		if _, ok := identifier.ProfileOf(dialect); !ok {
+			return nil, fmt.Errorf("no identifier rules for the target dialect %s, want one of %s",
+				dialect, strings.Join(identifier.Dialects(), ", "))
+		}
+	}
+
+	if quoting == EmptyString {
+		quoting = configs.ConfigChangeSet().Quoting
+	}
+	parsed, err := ParseQuoting(quoting)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.Quoting = parsed
+	policy.MaxLength = conf.IdentifierLength
+	policy.ReservedWords = conf.ReservedWords
+
+	return policy, nil
+}
+
+func identifierPolicy(args *Args) *IdentifierPolicy {
+	if args.Identifiers == nil {
+		args.Identifiers = DefaultIdentifierPolicy()
+	}
+
+	return args.Identifiers
+}
+
+// ----------------------------------------------------------------
+
+// quote checks the identifiers of the changeSet on the target dialects, sets its
+// objectQuotingStrategy when one of them is reserved, and records the issues.
+func (p *IdentifierPolicy) quote(args *Args, changeSet *ChangeSet, identifiers []*Identifier) {
+	rules := p.rules(args)
+	limit, limitOf := rules.Limit()
+
+	var issues []*IdentifierIssue
+	seen := make(map[Identifier]bool)
+	for _, it := range identifiers {
+		id := Identifier{Kind: it.Kind, Name: identifier.Unquote(it.Name)}
+		if id.Name == EmptyString || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		issue := &IdentifierIssue{ChangeSet: changeSet.ID, Identifier: id, Reserved: rules.ReservedBy(id.Name)}
+		if limit > 0 && len(id.Name) > limit {
+			issue.Length, issue.Limit, issue.LimitOf = len(id.Name), limit, limitOf
+		}
+
+		if len(issue.Reserved) > 0 || issue.Length > 0 {
+			issues = append(issues, issue)
+		}
+	}
+
+	for _, issue := range issues {
+		if len(issue.Reserved) == 0 {
+			continue
+		}
+		if changeSet.ObjectQuotingStrategy == EmptyString {
+			changeSet.ObjectQuotingStrategy = p.Quoting.Strategy()
+		}
+		// Liquibase quotes only the words its database reserves, not the configured ones.
+		if p.Quoting == QuotingReserved && len(issue.Reserved) == 1 && issue.Reserved[0] == identifier.Configured {
+			changeSet.ObjectQuotingStrategy = QuotingAll.Strategy()
+		}
+	}
+	for _, issue := range issues {
+		issue.Strategy = changeSet.ObjectQuotingStrategy
+	}
+
+	p.issues = append(p.issues, issues...)
+}
+
+// Issues the identifier issues of the changeSets generated so far.
+func (p *IdentifierPolicy) Issues() []*IdentifierIssue {
+	return p.issues
+}
+
+// rules the identifier rules of the targets, else of the generated dialect when known, and
+// those configured.
+func (p *IdentifierPolicy) rules(args *Args) *identifier.Rules {
+	targets := p.Targets
+	if len(targets) == 0 {
+		targets = []string{args.Dialect}
+	}
+
+	rules := &identifier.Rules{MaxLength: p.MaxLength, ReservedWords: p.ReservedWords}
+	for _, dialect := range targets {
+		if profile, ok := identifier.ProfileOf(dialect); ok {
+			rules.Profiles = append(rules.Profiles, profile)
+		}
+	}
+
+	return rules
+}
+
+// ----------------------------------------------------------------
+
+// tableIdentifiers the names of the changeSet creating table: the table and its columns.
+func tableIdentifiers(table *ast.Table) []*Identifier {
+	identifiers := []*Identifier{{Kind: IdentifierTable, Name: table.Name}}
+	for _, column := range table.Columns {
+		identifiers = append(identifiers, &Identifier{Kind: IdentifierColumn, Name: column.Name})
+	}
+
+	return identifiers
+}
+
+// changeIdentifiers the names the change refers to, the old ones of a rename included.
+func changeIdentifiers(change *diff.Change) []*Identifier {
+	if change.Kind == diff.CreateTable {
+		return tableIdentifiers(change.Table)
+	}
+
+	identifiers := []*Identifier{{Kind: IdentifierTable, Name: change.Table.Name}}
+	if change.FromTable != nil {
+		identifiers = append(identifiers, &Identifier{Kind: IdentifierTable, Name: change.FromTable.Name})
+	}
+	if change.Column != nil {
+		identifiers = append(identifiers, &Identifier{Kind: IdentifierColumn, Name: change.Column.Name})
+	}
+	if change.From != nil {
+		identifiers = append(identifiers, &Identifier{Kind: IdentifierColumn, Name: change.From.Name})
+	}
+	if change.Index != nil {
+		identifiers = append(identifiers, &Identifier{Kind: IdentifierIndex, Name: change.Index.Name})
+		for _, column := range change.Index.Columns {
+			identifiers = append(identifiers, &Identifier{Kind: IdentifierColumn, Name: column})
+		}
+	}
+
+	return identifiers
+}
+
+// ----------------------------------------------------------------
+
+// reportIdentifiers lists the reserved and over-long identifiers of the generated changeSets,
+// and the objectQuotingStrategy set on them; over-long names cannot be fixed by quoting and
+// are left to rename.
+func reportIdentifiers(args *Args) {
+	if args.Identifiers == nil || len(args.Identifiers.issues) == 0 {
+		return
+	}
+
+	reserved, tooLong := 0, 0
+	quoted := make(map[string]bool)
+
+	fmt.Println("")
+	fmt.Println(green("---------------- $ start liquigen identifiers report ----------------"))
+	changeSet := EmptyString
+	for _, issue := range args.Identifiers.issues {
+		if issue.ChangeSet != changeSet {
+			changeSet = issue.ChangeSet
+			if issue.Strategy != EmptyString {
+				quoted[changeSet] = true
+				fmt.Println(blue("ChangeSet:"), changeSet, yellow("objectQuotingStrategy="+issue.Strategy))
+			} else {
+				fmt.Println(blue("ChangeSet:"), changeSet)
+			}
+		}
+
+		if len(issue.Reserved) > 0 {
+			reserved++
+			fmt.Println("  -", yellow("[reserved]"), issue.Kind, cyan(issue.Name), "reserved by", strings.Join(issue.Reserved, ", "))
+		}
+		if issue.Length > 0 {
+			tooLong++
+			fmt.Println("  -", red("[too long]"), issue.Kind, cyan(issue.Name),
+				fmt.Sprintf("is %d bytes long, over the %d of %s, rename it", issue.Length, issue.Limit, issue.LimitOf))
+		}
+	}
+	fmt.Println(blue("Identifiers:"), fmt.Sprintf("%d reserved, %d too long, %d changeSets quoted", reserved, tooLong, len(quoted)))
+	fmt.Println(green("---------------- $ end liquigen identifiers report ----------------"))
+	fmt.Println("")
+}
@@ -0,0 +1,178 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/internal/cmd/database/diff"
+)
+
+func TestIdentifierPolicy_quote(t *testing.T) {
+	order := &ast.Table{Name: "`order`", Columns: []*ast.Column{
+		{Name: "`id`"},
+		{Name: "`user`"},
+		{Name: "`customer_shipping_address_lines`"},
+	}}
+
+	tests := []struct {
+		name         string
+		policy       *IdentifierPolicy
+		identifiers  []*Identifier
+		wantStrategy string
+		want         []string
+	}{
+		{name: "generated dialect", policy: &IdentifierPolicy{Quoting: QuotingReserved},
+			identifiers:  tableIdentifiers(order),
+			wantStrategy: "QUOTE_ONLY_RESERVED_WORDS",
+			want:         []string{"table order reserved by mysql"}},
+		{name: "targets", policy: &IdentifierPolicy{Targets: []string{"postgresql", "oracle"}, Quoting: QuotingAll},
+			identifiers:  tableIdentifiers(order),
+			wantStrategy: "QUOTE_ALL_OBJECTS",
+			want: []string{
+				"table order reserved by postgres, oracle",
+				"column user reserved by postgres, oracle",
+				"column customer_shipping_address_lines 31 bytes over the 30 of oracle",
+			}},
+		{name: "quoting none", policy: &IdentifierPolicy{Targets: []string{"postgres"}, Quoting: QuotingNone},
+			identifiers: tableIdentifiers(order),
+			want: []string{
+				"table order reserved by postgres",
+				"column user reserved by postgres",
+			}},
+		{name: "configured", policy: &IdentifierPolicy{Targets: []string{"postgres"}, Quoting: QuotingReserved, MaxLength: 20, ReservedWords: []string{"TYPE"}},
+			identifiers:  []*Identifier{{Kind: IdentifierColumn, Name: "type"}, {Kind: IdentifierColumn, Name: "customer_shipping_address"}},
+			wantStrategy: "QUOTE_ALL_OBJECTS",
+			want: []string{
+				"column type reserved by liquigen.json",
+				"column customer_shipping_address 25 bytes over the 20 of liquigen.json",
+			}},
+		{name: "configured and dialect", policy: &IdentifierPolicy{Targets: []string{"postgres"}, Quoting: QuotingReserved, ReservedWords: []string{"user"}},
+			identifiers:  tableIdentifiers(order),
+			wantStrategy: "QUOTE_ONLY_RESERVED_WORDS",
+			want: []string{
+				"table order reserved by postgres",
+				"column user reserved by liquigen.json, postgres",
+			}},
+		{name: "configured quoting none", policy: &IdentifierPolicy{Targets: []string{"postgres"}, Quoting: QuotingNone, ReservedWords: []string{"type"}},
+			identifiers: []*Identifier{{Kind: IdentifierColumn, Name: "type"}},
+			want:        []string{"column type reserved by liquigen.json"}},
+		{name: "too long only", policy: &IdentifierPolicy{Targets: []string{"oracle"}, Quoting: QuotingReserved},
+			identifiers: []*Identifier{{Kind: IdentifierIndex, Name: "idx_customer_shipping_address_line"}},
+			want:        []string{"index idx_customer_shipping_address_line 34 bytes over the 30 of oracle"}},
+		{name: "none", policy: &IdentifierPolicy{Targets: []string{"postgres"}, Quoting: QuotingReserved},
+			identifiers: []*Identifier{{Kind: IdentifierTable, Name: "employee"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changeSet := &ChangeSet{ID: "order_20241027_001"}
+			tt.policy.quote(&Args{Dialect: "mysql"}, changeSet, tt.identifiers)
+
+			if changeSet.ObjectQuotingStrategy != tt.wantStrategy {
+				t.Errorf("quote() strategy = %q, want %q", changeSet.ObjectQuotingStrategy, tt.wantStrategy)
+			}
+
+			var got []string
+			for _, issue := range tt.policy.Issues() {
+				if issue.ChangeSet != changeSet.ID || issue.Strategy != tt.wantStrategy {
+					t.Errorf("quote() issue of %s with %q, want %s with %q", issue.ChangeSet, issue.Strategy, changeSet.ID, tt.wantStrategy)
+				}
+				if len(issue.Reserved) > 0 {
+					got = append(got, issue.Kind+" "+issue.Name+" reserved by "+strings.Join(issue.Reserved, ", "))
+				}
+				if issue.Length > 0 {
+					got = append(got, issue.Kind+" "+issue.Name+" "+strconv.Itoa(issue.Length)+" bytes over the "+strconv.Itoa(issue.Limit)+" of "+issue.LimitOf)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("quote() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChangeIdentifiers(t *testing.T) {
+	order := &ast.Table{Name: "order"}
+
+	tests := []struct {
+		name   string
+		change *diff.Change
+		want   []string
+	}{
+		{name: "rename table", change: &diff.Change{Kind: diff.RenameTable, Table: order, FromTable: &ast.Table{Name: "orders"}},
+			want: []string{"table order", "table orders"}},
+		{name: "rename column", change: &diff.Change{Kind: diff.RenameColumn, Table: order, Column: &ast.Column{Name: "user"}, From: &ast.Column{Name: "user_id"}},
+			want: []string{"table order", "column user", "column user_id"}},
+		{name: "create index", change: &diff.Change{Kind: diff.CreateIndex, Table: order, Index: &ast.Index{Name: "idx_desc", Columns: []string{"desc"}}},
+			want: []string{"table order", "index idx_desc", "column desc"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, it := range changeIdentifiers(tt.change) {
+				got = append(got, it.Kind+" "+it.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("changeIdentifiers() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderTableDiff_objectQuotingStrategy(t *testing.T) {
+	args := &Args{Author: "dev", Version: "1.0.0", Dialect: "mysql", Identifiers: &IdentifierPolicy{Targets: []string{"postgres"}, Quoting: QuotingReserved}}
+	table := &diff.TableDiff{Name: "order", Changes: []*diff.Change{
+		{Kind: diff.SetTableRemarks, Table: &ast.Table{Name: "order", Comment: "orders"}},
+	}}
+
+	content, err := renderTableDiff(args, "20241027", table)
+	if err != nil {
+		t.Fatalf("renderTableDiff() error = %v", err)
+	}
+	if !strings.Contains(content, `labels="v1.0.0" objectQuotingStrategy="QUOTE_ONLY_RESERVED_WORDS">`) {
+		t.Errorf("renderTableDiff() got = %s, want the objectQuotingStrategy", content)
+	}
+}
+
+func TestParseQuoting(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Quoting
+		wantErr bool
+	}{
+		{name: "", want: QuotingReserved},
+		{name: "reserved", want: QuotingReserved},
+		{name: "ALL", want: QuotingAll},
+		{name: "none", want: QuotingNone},
+		{name: "legacy", want: QuotingReserved, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseQuoting(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseQuoting() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseQuoting() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
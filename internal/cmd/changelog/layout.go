@@ -0,0 +1,186 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/photowey/liquigen/configs"
+	"github.com/photowey/liquigen/pkg/stringz"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	// ProjectTemplateDir the root of the embedded project templates.
+	ProjectTemplateDir = "liquibase-changelog"
+
+	DefaultProjectLayout = ProjectTemplateDir
+	// DefaultChangelogPathLayout the directory pattern of the changelogs, relative to the project.
+	DefaultChangelogPathLayout = "src/main/resources/liquibase/{{dialect}}/changelogs/v{{version}}"
+	DefaultChangelogFileLayout = "{{table}}_{{version}}.xml"
+	// DefaultMasterLayout the path pattern of the master changelog, relative to the project.
+	DefaultMasterLayout   = "src/main/resources/liquibase/{{dialect}}/master.xml"
+	DefaultBaselineLayout = "liquibase-baseline/{{dialect}}"
+)
+
+var (
+	_placeholderRegexp = regexp.MustCompile(`\{\{\s*(\w+)\s*}}`)
+	_placeholders      = []string{"dialect", "version", "table", "date"}
+)
+
+// ----------------------------------------------------------------
+
+// Layout where the generated files go, relative to the output directory.
+//
+// The changelog patterns accept the {{dialect}}, {{version}}, {{table}} and {{date}}
+// placeholders, e.g. db/changelog/{{dialect}}/{{version}} and {{table}}.xml.
+type Layout struct {
+	// Project the directory of the project scaffold.
+	Project string
+	// ChangelogPath the directory pattern of the changelogs.
+	ChangelogPath string
+	// ChangelogFile the file name pattern of the changelog of a table.
+	ChangelogFile string
+	// Master the path pattern of the master changelog, updated by the changelogs scaffold.
+	Master string
+	// Baseline the directory pattern of the DATABASECHANGELOG sync artefacts.
+	Baseline string
+}
+
+func DefaultLayout() *Layout {
+	return ProjectLayout(DefaultProjectLayout)
+}
+
+// ProjectLayout the default layout of the project scaffold in the project directory, its
+// changelogs and master changelog under its src/main/resources.
+func ProjectLayout(project string) *Layout {
+	return &Layout{
+		Project:       project,
+		ChangelogPath: path.Join(project, DefaultChangelogPathLayout),
+		ChangelogFile: DefaultChangelogFileLayout,
+		Master:        path.Join(project, DefaultMasterLayout),
+		Baseline:      DefaultBaselineLayout,
+	}
+}
+
+// ConfigLayout the layout of the output section of liquigen.json, the blank patterns
+// falling back to the default layout of its project.
+func ConfigLayout() (*Layout, error) {
+	output := configs.ConfigOutput()
+	layout := DefaultLayout()
+
+	if stringz.IsNotBlankString(output.Project) {
+		layout = ProjectLayout(output.Project)
+	}
+	if stringz.IsNotBlankString(output.Path) {
+		layout.ChangelogPath = output.Path
+	}
+	if stringz.IsNotBlankString(output.File) {
+		layout.ChangelogFile = output.File
+	}
+	if stringz.IsNotBlankString(output.Master) {
+		layout.Master = output.Master
+	}
+	if stringz.IsNotBlankString(output.Baseline) {
+		layout.Baseline = output.Baseline
+	}
+
+	if err := layout.validate(); err != nil {
+		return nil, err
+	}
+
+	return layout, nil
+}
+
+// ----------------------------------------------------------------
+
+// ProjectFile the path of a file of the project scaffold, e.g. pom.xml.
+func (l *Layout) ProjectFile(args *Args, name string) string {
+	return filepath.Join(args.Path, filepath.FromSlash(l.Project), filepath.FromSlash(name))
+}
+
+// TableChangelogFile the path of the changelog of a table.
+func (l *Layout) TableChangelogFile(args *Args, table, date string) string {
+	return filepath.Join(args.Path,
+		filepath.FromSlash(expandLayout(args, l.ChangelogPath, table, date)),
+		filepath.FromSlash(expandLayout(args, l.ChangelogFile, table, date)))
+}
+
+// MasterFile the path of the master changelog.
+func (l *Layout) MasterFile(args *Args) string {
+	return filepath.Join(args.Path, filepath.FromSlash(expandLayout(args, l.Master, EmptyString, EmptyString)))
+}
+
+// BaselineDir the directory of the DATABASECHANGELOG sync artefacts.
+func (l *Layout) BaselineDir(args *Args) string {
+	return filepath.Join(args.Path, filepath.FromSlash(expandLayout(args, l.Baseline, EmptyString, EmptyString)))
+}
+
+func (l *Layout) validate() error {
+	for _, pattern := range []string{l.ChangelogPath, l.ChangelogFile, l.Master, l.Baseline} {
+		for _, match := range _placeholderRegexp.FindAllStringSubmatch(pattern, -1) {
+			if stringz.ArrayNotContains(_placeholders, match[1]) {
+				return fmt.Errorf("layout: unknown placeholder %s in %s, want one of {{%s}}",
+					match[0], pattern, strings.Join(_placeholders, "}}, {{"))
+			}
+		}
+	}
+
+	if !strings.Contains(_placeholderRegexp.ReplaceAllString(l.ChangelogPath+"/"+l.ChangelogFile, "{{$1}}"), "{{table}}") {
+		return fmt.Errorf("layout: the changelog patterns %s/%s miss the {{table}} placeholder",
+			l.ChangelogPath, l.ChangelogFile)
+	}
+
+	for _, pattern := range []string{l.Master, l.Baseline} {
+		for _, match := range _placeholderRegexp.FindAllStringSubmatch(pattern, -1) {
+			if match[1] == "table" || match[1] == "date" {
+				return fmt.Errorf("layout: the pattern %s accepts only the {{dialect}} and {{version}} placeholders", pattern)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandLayout(args *Args, pattern, table, date string) string {
+	return _placeholderRegexp.ReplaceAllStringFunc(pattern, func(match string) string {
+		switch _placeholderRegexp.FindStringSubmatch(match)[1] {
+		case "dialect":
+			return args.Dialect
+		case "version":
+			return args.Version
+		case "table":
+			return table
+		default:
+			return date
+		}
+	})
+}
+
+// outputLayout the layout of args, the default one when unset.
+func outputLayout(args *Args) *Layout {
+	if args.Layout == nil {
+		return DefaultLayout()
+	}
+
+	return args.Layout
+}
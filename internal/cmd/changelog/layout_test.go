@@ -0,0 +1,102 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLayout_TableChangelogFile(t *testing.T) {
+	args := &Args{Path: "/repo", Dialect: "mysql", Version: "1.0.1"}
+
+	tests := []struct {
+		name   string
+		layout *Layout
+		want   string
+	}{
+		{
+			name:   "test default layout",
+			layout: DefaultLayout(),
+			want:   "/repo/liquibase-changelog/src/main/resources/liquibase/mysql/changelogs/v1.0.1/employee_1.0.1.xml",
+		},
+		{
+			name:   "test project layout",
+			layout: ProjectLayout("services/app"),
+			want:   "/repo/services/app/src/main/resources/liquibase/mysql/changelogs/v1.0.1/employee_1.0.1.xml",
+		},
+		{
+			name:   "test custom layout",
+			layout: &Layout{ChangelogPath: "db/changelog/{{dialect}}/{{version}}", ChangelogFile: "{{table}}.xml"},
+			want:   "/repo/db/changelog/mysql/1.0.1/employee.xml",
+		},
+		{
+			name:   "test date placeholder",
+			layout: &Layout{ChangelogPath: "db/{{ date }}", ChangelogFile: "{{table}}.xml"},
+			want:   "/repo/db/20241001/employee.xml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.layout.TableChangelogFile(args, "employee", "20241001"); got != filepath.FromSlash(tt.want) {
+				t.Errorf("TableChangelogFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayout_MasterFile(t *testing.T) {
+	args := &Args{Path: "/repo", Dialect: "mysql", Version: "1.0.1"}
+
+	tests := []struct {
+		name   string
+		layout *Layout
+		want   string
+	}{
+		{name: "test default layout", layout: DefaultLayout(), want: "/repo/liquibase-changelog/src/main/resources/liquibase/mysql/master.xml"},
+		{name: "test project layout", layout: ProjectLayout("app"), want: "/repo/app/src/main/resources/liquibase/mysql/master.xml"},
+		{name: "test current project", layout: ProjectLayout("."), want: "/repo/src/main/resources/liquibase/mysql/master.xml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.layout.MasterFile(args); got != filepath.FromSlash(tt.want) {
+				t.Errorf("MasterFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayout_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		layout  *Layout
+		wantErr bool
+	}{
+		{name: "test default layout", layout: DefaultLayout()},
+		{name: "test table in path", layout: &Layout{ChangelogPath: "db/{{table}}", ChangelogFile: "changelog.xml"}},
+		{name: "test unknown placeholder", layout: &Layout{ChangelogPath: "db/{{schema}}", ChangelogFile: "{{table}}.xml"}, wantErr: true},
+		{name: "test missing table", layout: &Layout{ChangelogPath: "db/{{version}}", ChangelogFile: "changelog.xml"}, wantErr: true},
+		{name: "test table in master", layout: &Layout{ChangelogPath: "db", ChangelogFile: "{{table}}.xml", Master: "db/{{table}}.xml"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.layout.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/photowey/liquigen/internal/cmd/database/lint"
+	"github.com/photowey/liquigen/internal/cmd/database/liquibase"
+	"github.com/photowey/liquigen/pkg/filez"
+)
+
+// ----------------------------------------------------------------
+
+// LintArgs the arguments of the lint command.
+type LintArgs struct {
+	// Changelog the changelog, usually a master.xml, its included changelogs followed.
+	Changelog string
+	Dialect   string
+	// FailOn the severity failing the lint with a non-zero exit.
+	FailOn lint.Severity
+	// JSON the writer of the JSON report, nil for the colored one.
+	JSON io.Writer
+}
+
+// ----------------------------------------------------------------
+
+// OnLint reports the problems of the changelog and of the changelogs it includes, and
+// exits non-zero when one is at least as severe as args.FailOn.
+func OnLint(args *LintArgs) {
+	path, err := filez.Clean(args.Changelog)
+	if err != nil {
+		panic(err)
+	}
+
+	result, err := liquibase.Lint(path, args.Dialect)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, problem := range result.Problems {
+		problem.File = workingRelative(problem.File)
+	}
+
+	if args.JSON != nil {
+		printLintJSON(args.JSON, result.Problems)
+	} else {
+		printProblems("Lint", result.Problems)
+		fmt.Println(yellow("Lint: linted ->"), cyan(fmt.Sprintf("%d changeSets in %d files, %d errors, %d warnings",
+			result.ChangeSets, len(result.Files), lint.Count(result.Problems, lint.Error), lint.Count(result.Problems, lint.Warning))))
+	}
+
+	if lint.Failed(result.Problems, args.FailOn) {
+		os.Exit(1)
+	}
+}
+
+func printProblems(prefix string, problems []*lint.Problem) {
+	for _, problem := range problems {
+		if problem.Severity == lint.Error {
+			fmt.Println(red(fmt.Sprintf("%s: error ->", prefix)), problem)
+		} else {
+			fmt.Println(yellow(fmt.Sprintf("%s: warning ->", prefix)), problem)
+		}
+	}
+}
+
+func printLintJSON(w io.Writer, problems []*lint.Problem) {
+	if problems == nil {
+		problems = []*lint.Problem{}
+	}
+
+	bytes, err := json.MarshalIndent(problems, EmptyString, "  ")
+	if err != nil {
+		panic(err)
+	}
+	_, _ = fmt.Fprintln(w, string(bytes))
+}
+
+// workingRelative the path relative to the working directory, when below it.
+func workingRelative(path string) string {
+	wd, err := os.Getwd()
+	if err != nil || path == EmptyString {
+		return path
+	}
+
+	rel, err := filepath.Rel(wd, path)
+	if err != nil || filepath.IsAbs(rel) || len(rel) > 1 && rel[:2] == ".." {
+		return path
+	}
+
+	return rel
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/internal/cmd/database/liquibase"
+	"github.com/photowey/liquigen/pkg/filez"
+)
+
+// ----------------------------------------------------------------
+
+// loadLiquibase rebuilds args.Ast by replaying the Liquibase changelog args.Liquibase,
+// and reports the changes liquigen can't model.
+func loadLiquibase(args *Args) {
+	changelogFile, err := filez.Clean(args.Liquibase)
+	if err != nil {
+		panic(err)
+	}
+
+	result, err := liquibase.Import(changelogFile, args.Dialect)
+	if err != nil {
+		panic(err)
+	}
+
+	result.Database.Name = args.Database
+	args.Ast = &ast.Ast{
+		Database: result.Database,
+	}
+
+	fmt.Println(yellow("Liquibase: imported ->"), cyan(fmt.Sprintf("%d tables from %d changeSets in %d files",
+		len(result.Database.Tables), result.ChangeSets, len(result.Files))))
+
+	for _, unsupported := range result.Unsupported {
+		fmt.Println(red("Liquibase: unsupported ->"), unsupported)
+	}
+}
@@ -52,36 +52,6 @@ var promptTemplates = &promptui.PromptTemplates{
 
 // ----------------------------------------------------------------
 
-func validatePath(args *Args) {
-	if stringz.IsBlankString(args.Path) {
-		validate := func(input string) error {
-			if stringz.IsBlankString(input) {
-				return fmt.Errorf("empty output dir")
-			}
-			return nil
-		}
-
-		defaultPath := args.Cwd
-
-		prompt := promptui.Prompt{
-			Label:     "Output dir",
-			Validate:  validate,
-			Templates: promptTemplates,
-			Default:   defaultPath,
-		}
-
-		result, err := prompt.Run()
-		if err != nil {
-			fmt.Printf("Please enter the correct project dir: %v\n", err)
-
-			// Loop
-			validatePath(args)
-		}
-
-		args.Path = result
-	}
-}
-
 func validateAuthor(args *Args) {
 	if stringz.IsBlankString(args.Author) {
 		project := configs.ConfigProject()
@@ -0,0 +1,185 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/photowey/liquigen/pkg/stringz"
+	"gopkg.in/yaml.v3"
+)
+
+// ----------------------------------------------------------------
+
+// Scope how many times a template of the manifest is generated.
+type Scope string
+
+const (
+	// ScopeProject once per project.
+	ScopeProject Scope = "project"
+	// ScopeDialect once per dialect, e.g. the master changelogs.
+	ScopeDialect Scope = "dialect"
+	// ScopeVersion once per generated version, e.g. an aggregate of the changelogs of the version.
+	ScopeVersion Scope = "version"
+	// ScopeTable once per table, the changelogs.
+	ScopeTable Scope = "table"
+
+	// ManifestFile the manifest of a template set, at its root.
+	ManifestFile = "manifest.yaml"
+)
+
+var (
+	_scopes = []Scope{ScopeProject, ScopeDialect, ScopeVersion, ScopeTable}
+
+	// DefaultDialects the dialects of the dialect scope.
+	DefaultDialects = []string{"mysql", "postgres", "sqlite"}
+
+	_manifestPlaceholders = []string{"project", "master", "package", "dialect", "version", "date", "table"}
+)
+
+// ----------------------------------------------------------------
+
+// Manifest the files of a template set.
+type Manifest struct {
+	Files []*ManifestEntry `yaml:"files"`
+}
+
+// ManifestEntry one template of the manifest.
+type ManifestEntry struct {
+	// Template the name of the template, e.g. builds/maven/pom.xml.tmpl.
+	Template string `yaml:"template"`
+	Scope    Scope  `yaml:"scope"`
+	// Path the output path pattern, relative to the output directory, e.g.
+	// {{project}}/src/main/resources/application.yml, {{master}} the master changelog of the
+	// output layout. The changelog of a table without path follows the output layout.
+	Path string `yaml:"path"`
+	// Render whether the template is rendered, or copied as is.
+	Render bool `yaml:"render"`
+	// Build the build tool the file belongs to, every build when blank.
+	Build Build `yaml:"build"`
+	// Dialects the dialects of the dialect scope, DefaultDialects when empty.
+	Dialects []string `yaml:"dialects"`
+}
+
+// readManifest reads and validates the manifest of templates.
+func readManifest(templates *Templates) (*Manifest, error) {
+	content, err := templates.FindString(ManifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("manifest: read %s failed: %v", ManifestFile, err)
+	}
+
+	manifest := &Manifest{}
+	if err = yaml.Unmarshal([]byte(content), manifest); err != nil {
+		return nil, fmt.Errorf("manifest: parse %s failed: %v", ManifestFile, err)
+	}
+
+	if err = manifest.validate(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (m *Manifest) validate() error {
+	for _, entry := range m.Files {
+		if stringz.IsBlankString(entry.Template) {
+			return fmt.Errorf("manifest: a file misses its template")
+		}
+		if !testIsScope(entry.Scope) {
+			return fmt.Errorf("manifest: unknown scope %q of %s, want project, dialect, version or table",
+				entry.Scope, entry.Template)
+		}
+		if entry.Build != EmptyString && entry.Build != BuildMaven && entry.Build != BuildGradle {
+			return fmt.Errorf("manifest: unknown build %q of %s, want maven or gradle", entry.Build, entry.Template)
+		}
+		if stringz.IsBlankString(entry.Path) && entry.Scope != ScopeTable {
+			return fmt.Errorf("manifest: the %s file %s misses its path", entry.Scope, entry.Template)
+		}
+
+		for _, match := range _placeholderRegexp.FindAllStringSubmatch(entry.Path, -1) {
+			if stringz.ArrayNotContains(_manifestPlaceholders, match[1]) {
+				return fmt.Errorf("manifest: unknown placeholder %s in %s, want one of {{%s}}",
+					match[0], entry.Path, strings.Join(_manifestPlaceholders, "}}, {{"))
+			}
+			if match[1] == "table" && entry.Scope != ScopeTable {
+				return fmt.Errorf("manifest: the placeholder %s of %s is only available to the table scope",
+					match[0], entry.Path)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Entries the files of scope generated for the build tool of project.
+func (m *Manifest) Entries(scope Scope, project *Project) []*ManifestEntry {
+	var entries []*ManifestEntry
+	for _, entry := range m.Files {
+		if entry.Scope != scope {
+			continue
+		}
+		if entry.Build != EmptyString && entry.Build != project.Build {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// dialects the dialects of a dialect scope entry.
+func (e *ManifestEntry) dialects() []string {
+	if len(e.Dialects) == 0 {
+		return DefaultDialects
+	}
+
+	return e.Dialects
+}
+
+// OutputPath the path of the file generated at date, for the table of the table scope.
+func (e *ManifestEntry) OutputPath(args *Args, table, date string) string {
+	project := projectOf(args)
+	layout := outputLayout(args)
+
+	path := _placeholderRegexp.ReplaceAllStringFunc(e.Path, func(match string) string {
+		switch _placeholderRegexp.FindStringSubmatch(match)[1] {
+		case "project":
+			return layout.Project
+		case "master":
+			return expandLayout(args, layout.Master, table, date)
+		case "package":
+			return project.PackageDir()
+		default:
+			return expandLayout(args, match, table, date)
+		}
+	})
+
+	return filepath.Join(args.Path, filepath.FromSlash(path))
+}
+
+func testIsScope(scope Scope) bool {
+	for _, it := range _scopes {
+		if it == scope {
+			return true
+		}
+	}
+
+	return false
+}
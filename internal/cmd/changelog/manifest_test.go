@@ -0,0 +1,190 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadManifest_Embedded(t *testing.T) {
+	templates := NewTemplates(EmptyString)
+
+	manifest, err := readManifest(templates)
+	if err != nil {
+		t.Fatalf("readManifest() error = %v", err)
+	}
+
+	declared := make(map[string]bool)
+	for _, entry := range manifest.Files {
+		if _, err = templates.FindString(entry.Template); err != nil {
+			t.Errorf("readManifest() got missing template %s", entry.Template)
+		}
+		declared[entry.Template] = true
+	}
+
+	for _, name := range templates.Embedded() {
+		if strings.HasSuffix(name, TmplSuffix) && !declared[name] {
+			t.Errorf("readManifest() got undeclared template %s", name)
+		}
+	}
+}
+
+func TestManifest_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		entry   *ManifestEntry
+		wantErr bool
+	}{
+		{name: "test project", entry: &ManifestEntry{Template: "a.tmpl", Scope: ScopeProject, Path: "{{project}}/a"}},
+		{name: "test table without path", entry: &ManifestEntry{Template: "a.tmpl", Scope: ScopeTable}},
+		{name: "test missing template", entry: &ManifestEntry{Scope: ScopeProject, Path: "a"}, wantErr: true},
+		{name: "test unknown scope", entry: &ManifestEntry{Template: "a.tmpl", Scope: "module", Path: "a"}, wantErr: true},
+		{name: "test unknown build", entry: &ManifestEntry{Template: "a.tmpl", Scope: ScopeProject, Path: "a", Build: "ant"}, wantErr: true},
+		{name: "test missing path", entry: &ManifestEntry{Template: "a.tmpl", Scope: ScopeDialect}, wantErr: true},
+		{name: "test unknown placeholder", entry: &ManifestEntry{Template: "a.tmpl", Scope: ScopeProject, Path: "{{module}}/a"}, wantErr: true},
+		{name: "test table outside table scope", entry: &ManifestEntry{Template: "a.tmpl", Scope: ScopeVersion, Path: "{{table}}.xml"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := &Manifest{Files: []*ManifestEntry{tt.entry}}
+			if err := manifest.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestManifest_Entries(t *testing.T) {
+	manifest := &Manifest{Files: []*ManifestEntry{
+		{Template: "pom.xml.tmpl", Scope: ScopeProject, Build: BuildMaven},
+		{Template: "build.gradle.kts.tmpl", Scope: ScopeProject, Build: BuildGradle},
+		{Template: "README.md.tmpl", Scope: ScopeProject},
+		{Template: "table.xml.tmpl", Scope: ScopeTable},
+	}}
+
+	var got []string
+	for _, entry := range manifest.Entries(ScopeProject, &Project{Build: BuildGradle}) {
+		got = append(got, entry.Template)
+	}
+
+	if want := "build.gradle.kts.tmpl,README.md.tmpl"; strings.Join(got, ",") != want {
+		t.Errorf("Entries() got = %v, want %v", got, want)
+	}
+}
+
+func TestManifestEntry_OutputPath(t *testing.T) {
+	project := DefaultProject()
+	project.Package = "com.acme.billing"
+	args := &Args{Path: "/repo", Dialect: "mysql", Version: "1.0.1", Project: project}
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "test project", path: "{{project}}/src/main/java/{{package}}/App.java", want: "/repo/liquibase-changelog/src/main/java/com/acme/billing/App.java"},
+		{name: "test dialect", path: "db/{{dialect}}/master.xml", want: "/repo/db/mysql/master.xml"},
+		{name: "test table", path: "db/{{version}}/{{table}}_{{date}}.xml", want: "/repo/db/1.0.1/employee_20241001.xml"},
+		{name: "test master", path: "{{master}}", want: "/repo/liquibase-changelog/src/main/resources/liquibase/mysql/master.xml"},
+		{name: "test test package", path: "{{project}}/src/test/java/{{package}}/AppTests.java", want: "/repo/liquibase-changelog/src/test/java/com/acme/billing/AppTests.java"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := &ManifestEntry{Path: tt.path}
+			if got := entry.OutputPath(args, "employee", "20241001"); got != filepath.FromSlash(tt.want) {
+				t.Errorf("OutputPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManifestEntry_OutputPath_layout(t *testing.T) {
+	layout := &Layout{Project: "app", ChangelogPath: "db/changelog/{{dialect}}", ChangelogFile: "{{table}}.xml",
+		Master: "db/changelog/{{dialect}}/db.changelog-master.xml"}
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "test master", path: "{{master}}", want: "/repo/db/changelog/postgres/db.changelog-master.xml"},
+		{name: "test project", path: "{{project}}/pom.xml", want: "/repo/app/pom.xml"},
+		{name: "test default package", path: "{{project}}/src/main/java/{{package}}/App.java", want: "/repo/app/src/main/java/io/github/photowey/liquibase/changelog/App.java"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := &Args{Path: "/repo", Dialect: "postgres", Version: "1.0.1", Project: DefaultProject(), Layout: layout}
+
+			entry := &ManifestEntry{Path: tt.path}
+			if got := entry.OutputPath(args, EmptyString, "20241001"); got != filepath.FromSlash(tt.want) {
+				t.Errorf("OutputPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManifest_Entries_build(t *testing.T) {
+	manifest, err := readManifest(NewTemplates(EmptyString))
+	if err != nil {
+		t.Fatalf("readManifest() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		build   Build
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "test maven",
+			build:   BuildMaven,
+			want:    []string{"/repo/liquibase-changelog/pom.xml"},
+			notWant: []string{"/repo/liquibase-changelog/build.gradle.kts", "/repo/liquibase-changelog/settings.gradle.kts"},
+		},
+		{
+			name:    "test gradle",
+			build:   BuildGradle,
+			want:    []string{"/repo/liquibase-changelog/build.gradle.kts", "/repo/liquibase-changelog/settings.gradle.kts", "/repo/liquibase-changelog/liquibase.properties"},
+			notWant: []string{"/repo/liquibase-changelog/pom.xml"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := DefaultProject()
+			project.Build = tt.build
+			args := &Args{Path: "/repo", Dialect: "mysql", Version: "1.0.1", Project: project}
+
+			paths := make(map[string]bool)
+			for _, entry := range manifest.Entries(ScopeProject, project) {
+				paths[entry.OutputPath(args, EmptyString, "20241001")] = true
+			}
+
+			for _, want := range tt.want {
+				if !paths[filepath.FromSlash(want)] {
+					t.Errorf("Entries() got no %s", want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if paths[filepath.FromSlash(notWant)] {
+					t.Errorf("Entries() got %s of another build", notWant)
+				}
+			}
+		})
+	}
+}
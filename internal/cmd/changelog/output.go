@@ -0,0 +1,379 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/photowey/liquigen/pkg/diffz"
+)
+
+// ----------------------------------------------------------------
+
+// ConflictMode how a generated file replaces an existing file with a different content.
+type ConflictMode string
+
+const (
+	// ConflictFail writes nothing when any file conflicts.
+	ConflictFail ConflictMode = "fail"
+	// ConflictSkip keeps the existing files.
+	ConflictSkip ConflictMode = "skip"
+	// ConflictOverwrite replaces the existing files.
+	ConflictOverwrite ConflictMode = "overwrite"
+	// ConflictNew keeps the existing files and writes the generated ones next to them, as <file>.new.
+	ConflictNew ConflictMode = "new"
+
+	NewFileSuffix = ".new"
+)
+
+// FileAction what the generation did to one file.
+type FileAction string
+
+const (
+	FileCreated   FileAction = "created"
+	FileUnchanged FileAction = "unchanged"
+	FileSkipped   FileAction = "skipped"
+	FileReplaced  FileAction = "replaced"
+	FileUpdated   FileAction = "updated"
+	FileNew       FileAction = "new"
+	FileConflict  FileAction = "conflict"
+)
+
+var _conflictModes = []ConflictMode{ConflictFail, ConflictSkip, ConflictOverwrite, ConflictNew}
+
+// ----------------------------------------------------------------
+
+// ParseConflictMode parses the name of a conflict mode, fail by default.
+func ParseConflictMode(name string) (ConflictMode, error) {
+	if name == EmptyString {
+		return ConflictFail, nil
+	}
+
+	for _, mode := range _conflictModes {
+		if strings.EqualFold(string(mode), name) {
+			return mode, nil
+		}
+	}
+
+	return ConflictFail, fmt.Errorf("unknown conflict mode %q, want fail, skip, overwrite or new", name)
+}
+
+// ----------------------------------------------------------------
+
+// OutputFile one generated file.
+type OutputFile struct {
+	Path    string
+	Content []byte
+	Perm    fs.FileMode
+	Action  FileAction
+	// Changelog whether the file is a changelog document, streamed by --stdout.
+	Changelog bool
+	// Update whether the content updates the existing file, which is then replaced
+	// whatever the conflict mode, e.g. the includes added to the master changelog.
+	Update bool
+	// Existing the content of the existing file, set once flushed.
+	Existing []byte
+}
+
+// Output collects the generated files, then writes them all at once according to the
+// conflict mode, so that a conflict in fail mode leaves the project untouched.
+//
+// A dry run resolves the actions without writing anything, and a stdout output streams
+// the changelog documents to stdout instead of writing the files.
+type Output struct {
+	mode   ConflictMode
+	dryRun bool
+	stdout io.Writer
+	files  []*OutputFile
+}
+
+func NewOutput(args *Args) *Output {
+	return &Output{
+		mode:   args.Conflict,
+		dryRun: args.DryRun,
+		stdout: args.Stdout,
+	}
+}
+
+// Add plans the writing of content into path.
+func (o *Output) Add(path string, content []byte, perm fs.FileMode) {
+	o.files = append(o.files, &OutputFile{Path: path, Content: content, Perm: perm})
+}
+
+// AddChangelog plans the writing of the changelog document content into path.
+func (o *Output) AddChangelog(path string, content []byte, perm fs.FileMode) {
+	o.files = append(o.files, &OutputFile{Path: path, Content: content, Perm: perm, Changelog: true})
+}
+
+// AddUpdate plans the update of the existing file path with content.
+func (o *Output) AddUpdate(path string, content []byte, perm fs.FileMode) {
+	o.files = append(o.files, &OutputFile{Path: path, Content: content, Perm: perm, Update: true})
+}
+
+// Files the planned files, with their action once flushed.
+func (o *Output) Files() []*OutputFile {
+	return o.files
+}
+
+// ChangelogsWritten reports whether the changelog documents, once flushed, all hold their
+// generated content: none was skipped, or written next to the existing file.
+func (o *Output) ChangelogsWritten() bool {
+	for _, file := range o.files {
+		if !file.Changelog {
+			continue
+		}
+
+		switch file.Action {
+		case FileCreated, FileUnchanged, FileReplaced:
+		default:
+			return false
+		}
+	}
+
+	return !o.dryRun && o.stdout == nil
+}
+
+// Flush resolves the action of every planned file and writes them. In fail mode nothing
+// is written when any file conflicts, and the error lists the conflicting files.
+func (o *Output) Flush() error {
+	if o.stdout != nil {
+		return o.stream()
+	}
+
+	var conflicts []string
+	for _, file := range o.files {
+		action, err := o.resolve(file)
+		if err != nil {
+			return err
+		}
+
+		file.Action = action
+		if action == FileConflict {
+			conflicts = append(conflicts, file.Path)
+		}
+	}
+
+	if o.dryRun {
+		return nil
+	}
+	if len(conflicts) > 0 {
+		return &ConflictError{Files: conflicts}
+	}
+
+	for _, file := range o.files {
+		path := file.Path
+		switch file.Action {
+		case FileUnchanged, FileSkipped:
+			continue
+		case FileNew:
+			path += NewFileSuffix
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, file.Content, file.Perm); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stream writes the changelog documents to stdout, separated by a blank line.
+func (o *Output) stream() error {
+	separator := EmptyString
+	for _, file := range o.files {
+		if !file.Changelog {
+			continue
+		}
+
+		content := strings.TrimSuffix(string(file.Content), "\n")
+		if _, err := fmt.Fprint(o.stdout, separator+content+"\n"); err != nil {
+			return err
+		}
+
+		separator = "\n"
+	}
+
+	return nil
+}
+
+func (o *Output) resolve(file *OutputFile) (FileAction, error) {
+	existing, err := os.ReadFile(file.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return FileCreated, nil
+	}
+	if err != nil {
+		return EmptyString, err
+	}
+
+	file.Existing = existing
+
+	if bytes.Equal(existing, file.Content) {
+		return FileUnchanged, nil
+	}
+	if file.Update {
+		return FileUpdated, nil
+	}
+
+	switch o.mode {
+	case ConflictSkip:
+		return FileSkipped, nil
+	case ConflictOverwrite:
+		return FileReplaced, nil
+	case ConflictNew:
+		return FileNew, nil
+	default:
+		return FileConflict, nil
+	}
+}
+
+// ----------------------------------------------------------------
+
+// ConflictError the existing files a generation in fail mode would have changed.
+type ConflictError struct {
+	Files []string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%d existing files would be changed", len(e.Files))
+}
+
+// ----------------------------------------------------------------
+
+// flushOutput reports the reserved and over-long identifiers, writes the output and reports
+// what was created, skipped or replaced, and whether the changelogs were all written; a
+// conflict in fail mode exits non-zero.
+func flushOutput(args *Args, output *Output) bool {
+	reportIdentifiers(args)
+
+	err := output.Flush()
+	if err == nil && args.Stdout != nil {
+		return false
+	}
+	if err == nil && args.DryRun {
+		reportDryRun(args, output)
+
+		return false
+	}
+
+	var conflict *ConflictError
+	if errors.As(err, &conflict) {
+		for _, file := range conflict.Files {
+			fmt.Println(red("File: conflict ->"), cyan(relativePath(args, file)))
+		}
+		fmt.Println(red(fmt.Sprintf("File: %v, nothing written; "+
+			"use --force to overwrite, --skip-existing to keep them or --conflict new to write *.new files", err)))
+		os.Exit(1)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	reportOutput(args, output)
+
+	return output.ChangelogsWritten()
+}
+
+func reportOutput(args *Args, output *Output) {
+	for _, file := range output.Files() {
+		switch file.Action {
+		case FileSkipped:
+			fmt.Println(yellow("File: skipped ->"), cyan(relativePath(args, file.Path)))
+		case FileReplaced:
+			fmt.Println(yellow("File: replaced ->"), cyan(relativePath(args, file.Path)))
+		case FileUpdated:
+			fmt.Println(yellow("File: updated ->"), cyan(relativePath(args, file.Path)))
+		case FileNew:
+			fmt.Println(yellow("File: written ->"), cyan(relativePath(args, file.Path+NewFileSuffix)))
+		}
+	}
+
+	summarizeOutput(output)
+}
+
+func summarizeOutput(output *Output) {
+	counts := make(map[FileAction]int)
+	for _, file := range output.Files() {
+		counts[file.Action]++
+	}
+
+	summary := fmt.Sprintf("%d created, %d unchanged, %d skipped, %d replaced, %d new",
+		counts[FileCreated], counts[FileUnchanged], counts[FileSkipped], counts[FileReplaced], counts[FileNew])
+	if counts[FileUpdated] > 0 {
+		summary += fmt.Sprintf(", %d updated", counts[FileUpdated])
+	}
+	if counts[FileConflict] > 0 {
+		summary += fmt.Sprintf(", %d conflicts", counts[FileConflict])
+	}
+
+	fmt.Println(blue("Files:"), summary)
+}
+
+// reportDryRun lists every file the generation would write, with the unified diff
+// against the existing file.
+func reportDryRun(args *Args, output *Output) {
+	fmt.Println("")
+	fmt.Println(green("---------------- $ start liquigen dry run report ----------------"))
+	for _, file := range output.Files() {
+		path := relativePath(args, file.Path)
+		if file.Action == FileNew {
+			path += NewFileSuffix
+		}
+
+		fmt.Println(renderAction(file.Action), cyan(path))
+
+		if file.Action != FileCreated && file.Action != FileUnchanged {
+			name := strings.TrimPrefix(path, "$pwd/")
+			fmt.Print(diffz.Unified("a/"+name, "b/"+name, string(file.Existing), string(file.Content), diffz.DefaultContext))
+		}
+	}
+	fmt.Println(green("---------------- $ end liquigen dry run report ----------------"))
+	fmt.Println("")
+
+	summarizeOutput(output)
+	fmt.Println(yellow("Dry run: nothing written"))
+}
+
+func renderAction(action FileAction) string {
+	label := fmt.Sprintf("[%s]", action)
+
+	switch action {
+	case FileConflict:
+		return red(label)
+	case FileReplaced, FileUpdated, FileNew, FileSkipped:
+		return yellow(label)
+	default:
+		return green(label)
+	}
+}
+
+func relativePath(args *Args, path string) string {
+	if rel, err := filepath.Rel(args.Cwd, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return "$pwd/" + filepath.ToSlash(rel)
+	}
+
+	return path
+}
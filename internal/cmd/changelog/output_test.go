@@ -0,0 +1,163 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOutput_Flush(t *testing.T) {
+	tests := []struct {
+		name        string
+		mode        ConflictMode
+		wantAction  FileAction
+		wantContent string
+		wantNew     bool
+		wantErr     bool
+	}{
+		{name: "test fail", mode: ConflictFail, wantAction: FileConflict, wantContent: "custom", wantErr: true},
+		{name: "test skip", mode: ConflictSkip, wantAction: FileSkipped, wantContent: "custom"},
+		{name: "test overwrite", mode: ConflictOverwrite, wantAction: FileReplaced, wantContent: "generated"},
+		{name: "test new", mode: ConflictNew, wantAction: FileNew, wantContent: "custom", wantNew: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			existing := filepath.Join(dir, "pom.xml")
+			unchanged := filepath.Join(dir, "README.md")
+			created := filepath.Join(dir, "src", "master.xml")
+
+			if err := os.WriteFile(existing, []byte("custom"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(unchanged, []byte("readme"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			output := NewOutput(&Args{Conflict: tt.mode})
+			output.Add(existing, []byte("generated"), 0o644)
+			output.Add(unchanged, []byte("readme"), 0o644)
+			output.Add(created, []byte("master"), 0o644)
+
+			err := output.Flush()
+			var conflict *ConflictError
+			if (err != nil) != tt.wantErr || (tt.wantErr && !errors.As(err, &conflict)) {
+				t.Fatalf("Flush() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			files := output.Files()
+			if files[0].Action != tt.wantAction || files[1].Action != FileUnchanged || files[2].Action != FileCreated {
+				t.Errorf("Flush() got actions = %s, %s, %s", files[0].Action, files[1].Action, files[2].Action)
+			}
+
+			if content, _ := os.ReadFile(existing); string(content) != tt.wantContent {
+				t.Errorf("Flush() got content = %s, want %s", content, tt.wantContent)
+			}
+			if _, err = os.Stat(existing + NewFileSuffix); (err == nil) != tt.wantNew {
+				t.Errorf("Flush() got .new file = %v, want %v", err == nil, tt.wantNew)
+			}
+			if _, err = os.Stat(created); (err == nil) == tt.wantErr {
+				t.Errorf("Flush() got created file = %v, want %v", err == nil, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOutput_ChangelogsWritten(t *testing.T) {
+	tests := []struct {
+		name string
+		mode ConflictMode
+		want bool
+	}{
+		{name: "test skip", mode: ConflictSkip, want: false},
+		{name: "test overwrite", mode: ConflictOverwrite, want: true},
+		{name: "test new", mode: ConflictNew, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			existing := filepath.Join(dir, "employee_1.0.0.xml")
+			if err := os.WriteFile(existing, []byte("custom"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			output := NewOutput(&Args{Conflict: tt.mode})
+			output.AddChangelog(existing, []byte("generated"), 0o644)
+			output.AddChangelog(filepath.Join(dir, "department_1.0.0.xml"), []byte("generated"), 0o644)
+			output.Add(filepath.Join(dir, "pom.xml"), []byte("pom"), 0o644)
+
+			if err := output.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+			if got := output.ChangelogsWritten(); got != tt.want {
+				t.Errorf("ChangelogsWritten() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutput_Flush_DryRun(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "pom.xml")
+	if err := os.WriteFile(existing, []byte("custom"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	output := NewOutput(&Args{Conflict: ConflictFail, DryRun: true})
+	output.Add(existing, []byte("generated"), 0o644)
+	output.Add(filepath.Join(dir, "master.xml"), []byte("master"), 0o644)
+
+	if err := output.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	files := output.Files()
+	if files[0].Action != FileConflict || string(files[0].Existing) != "custom" || files[1].Action != FileCreated {
+		t.Errorf("Flush() got actions = %s, %s", files[0].Action, files[1].Action)
+	}
+	if content, _ := os.ReadFile(existing); string(content) != "custom" {
+		t.Errorf("Flush() got content = %s, want custom", content)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "master.xml")); err == nil {
+		t.Errorf("Flush() got master.xml written")
+	}
+}
+
+func TestOutput_Flush_Stdout(t *testing.T) {
+	dir := t.TempDir()
+
+	var buf bytes.Buffer
+	output := NewOutput(&Args{Stdout: &buf})
+	output.Add(filepath.Join(dir, "pom.xml"), []byte("pom"), 0o644)
+	output.AddChangelog(filepath.Join(dir, "employee.xml"), []byte("employee\n"), 0o644)
+	output.AddChangelog(filepath.Join(dir, "department.xml"), []byte("department"), 0o644)
+
+	if err := output.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	if got, want := buf.String(), "employee\n\ndepartment\n"; got != want {
+		t.Errorf("Flush() got stdout = %q, want %q", got, want)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Flush() got %d files written, want none", len(entries))
+	}
+}
@@ -13,28 +13,33 @@ import (
 )
 
 var _ = func() error {
-	const gk = "0c5a90773c0909cf4f5fb1a793db77cf"
+	const gk = "fdbafeaa4034991143ec5e80ed95cf53"
 	g := packr.New(gk, "")
 	hgr, err := resolver.NewHexGzip(map[string]string{
-		"17432597fb18cbcdf1095b70c36fba9a": "1f8b08000000000000ff010000ffff0000000000000000",
-		"1d190baa7313f69cfb1fb316b52b8244": "1f8b08000000000000ff94954b6fe2481485d720f11f6a6a1dbbc8cc2c661010d13c242420e960a2ee5554d83776a9eb01ae4b8046fcf7961f013b2146a90dd8e79c8f7b2f5576fb6ea7247985d80aa33bf4d66d5202da3781d061872ebc91f31fbdeb36eaed80235f720bfd88eb1026266cd449be764a6adba111e2aac5d876bb75a5586f44e2764d1cb29d924c5b162cfd342b4d48df855b3b2b4a80ed3f69f2ef66f396fd984ee67e048a3b425be4da8762dc8a964dd589f139a64d7ca19033e80ba1e277e75ff77f776703da6dd43358fb2fc74969b6c558607c5be0f946b12ceae07e059669b00881833cb4cc3772a3b41ba192c471cebc2c30072422e8d0c3c121aec79712dc1957409ce3f139bd37e0985d359bb7b451afd56a35bec1c8c479a6975e248e5c0d96cae6da4070093e1644df68841d766800af3708166f2cf2106e56b109728be44b90b6435f53c453b68552c4a9f6ac7ea31468ec8eb540c1a5f80d04232098f4d02217fa69b3b7489913034748ad5938f15f1c082531281effb225b59f41df2a24857538903819f2d99afc15961c8fc50a32a743dc8758281eef33576afa60f92642a13177389f703ca1f7e393eb2267aeb894573c5308c4465d3195e50b869134bc9230309ba5846ac8007ca1b8ac36f5231e57fdd0138ffd2b160f7657da498e432541a84a3dc963d9f309c52257ab2ad46215241bf7aa95800e4ab7dbacb0e74b87e17020e285c0fa7c74dde97efe7d528e2b138897fd7c2d4976d6d5deaee5fbbddf8e6125b90f24ffecd0e9fd603cfa49faf793c574463669f5cfc92c08bef540cb8cd3da0a8caa00de783a9c7bbde903190c47bdc5c423fdc5e3e370e63d9f95fb19593c0c7adef0a34659b7389d537fddd2648a636cb3d3e333798bb10fafb16ea3fe270000ffffcf59449b04070000",
-		"1e52d4bcf26c573f0f83bfa45c8e7242": "1f8b08000000000000ff8453cd6edb3c10bceb29163e25813fca31be537db1f2535468600391d320287aa0a835b588cc65482a8a11e481fa1a7db282b402d44881ea242c676787b3c3fc2c8333b864bb77a4db00bf7ec27c36ff1f428bc08e3419d981ec43cb0ed88d7f5e6490fa6e48a1f1d8406f1a74a9a9b052b5f87e32856fe83cb181b998c149044cc6a3c9e92252ecb9879ddc83e100bd47082d79d85287802f0a6d0032a078673b9246210c14da346764894ae061e4e03a48322041b1dd036fff04820ca3e8f8b521d84f793e0c839049b060a7f3ee00f5f94d7979bdaaaeff9b8bd9d874673af41e1c3ef5e4b0817a0fd2da8e94ac3b844e0ec91ded101b081c450f8e02193d05cfdb30488791a6211f1cd57d38f2ec5d22f923001b90062645056535818ba22aab6924b92f375fd6771bb82f6e6f8bd5a6bcae607d0b97ebd555b929d7ab0ad69fa1583dc0d772753505a4d0a2037cb12ede801d5074139b645d85782461cb0749dea2a22d29e8a4d1bdd4089a9fd119321a2cba1df9b8550fd23491a6a31d051952e9c3bde2a03ccbac548f918858680a6d5f0bdb72e001f7a2a3a79e6ae951a8561a8d1deb4596d1ceb20bc04e0b6f1d19bd75728703bb4751330751a5627158439cbdf8678bec032b365bd2bdc391e082391c9164f959daf9eb527113136ddfc6142cc797f02e3cd59ec7849f8b598a0b2c3dc5a8c677949fcff2f359bafff2afd332dbd71d29509df43e8e82d72c8b091deb3ebaaae099a9819d24735285c8f2fd0748a7fd29bc2670fc3eb8215c6f4e0a6b45e29e1e1a1609ff96656fd9ef000000ffff5a250233fb030000",
-		"21607e2509eb56fd4f9f3c474dd36ab4": "1f8b08000000000000ffbc96df6fe24610c7df23e57fd8aefa7095ce5e938baa16e19c2220123a205180aaf784167b62afba3f9cdd71c057e57faf6c43628c4813a985175bf6cc773ff35def30bdaf1b25c91358278c0e69c70f28011d9958e824a48bf98df71bfd7a757ed68b39f21577d04fb94e606c92f333b2fd6d94d42ea42962d6656cbd5efb523ce6a28cf68d4dd84649a61d8b5751952b4d425bc9dd8d137b02eb2f55e6451074d89f93f12c4a41714f68875c47d04c77a2ebaab7631371ac8af800c8abd007929af7dea5ffbbbf7131bd3a3fabc57a3f795ea5e6ba8cc526720dbdc82856a77a5864e0980687107bc813c7222373a5fd1495249ef7aa5727cc008988430a2a93a600585e0417979da0132c83a04309cf313536a4596ad0aca1a0245e29175255b84749496434c206431ac3d36704879f1df20428917c05d285f4a9e3077e5056b133a41719a540e3d5480b145c8a1f403005827c25a14b761c3db68bdb01d7c91638c2bc8cad33a65cc12b3d251614b77fb9900e778f1a6b6fd72ffd20ba4a143125a56521fdf9eff2eaaf4422343e7fba087e6988dd59a1b82dc8b7e1f7ca1333d29185122fa40f5cba8355b62b6987960b8d8e64b5c23728428a36074a742e6559c14e81b541b73bb767c06105b523cb55f1ae42fa65b4b1ff8afb01b8e36879167f006d51459f086deb1a0a052db8f29143aeb2e7b66d40aaf0535af75ebedabb79197d4857fe6278e0b9c43fb8cca16f549623c421ed2feeef87d3f9723e9a0c67f3ebc91db99d92c5dde07a3e2407ef4e53780c1210daa712852eea4fa6f9c50cea58f220794282aed00249a7bb53f80f71df027e699cdab4a09fb88d526e9f3f7dd9a3de35263235fe693c353659beb3d1ddda846bf1a3fac723a3c1e9f8cabb63fefd7a7914b16cffa78174c61e7e9747b678662c9e6e7b1d7204d722abf676cfb71972ccdda98e45bd6cfb18c3069b6debbe0efa1f5ceab1c6a4b03f4228138b8762f628f74699b6a4854cf208c8f61ad2c9ed6074f39df46fc78bc99434fa3379e9c8477a2f590b4cdf12786dbf83e1cdf5623c3f6cbe6f3666b657f84b7ddba73df632e99503373b98b8afcecffe090000ffff3c0c2b43af0b0000",
-		"24a524a03b2f83ca2618ca2cf3c21568": "1f8b08000000000000ffd2522849ad28b14d2c2dc95748cdcfb1cd4903040000ffffc8b6eacc12000000",
-		"3f9304931980ffe765d5d90b67dade73": "1f8b08000000000000ffac54d16e9c30107ce72b10efd897b455a38810b595aa464a9a4897567d3566034ec0766d7390bfafb03198eb254ddbdc138c677767d67364e743dbc43b509a097e961ca14d1203a7a264bc3a4bbedd7e4e4f92f33ccaa412f7404d3cb40dd767496d8c3cc5b8253be08848426b404255f8e6fa0abf459bb18b659e0e9acdecbeef51ffc6f28e379b23fce3ea724b6b6849cab836845348a2d8ff06cd4eb53dbd149418abee8f53e391a10f51065d3a30b54434e832c9233b2e6b4509cd77b782dc1e6778854d3c49147093cf1ab34a894e5e94b95015d252315edd29d2422fd4032a843019f68ca58628c3ee08351765ee4ad291996a43940195ba19190e684bed744df9317a8fde65d8bf3a75d8cb8b56da98401533755720590b237a78dc53152a6ad8cf8e1544434a6bc22b6844f5bb9459c6d1b8aa74fbf5c3cdf6cbf56da0c7d1386921bf3cd4d19e3852099a2a26c7fb5db8f1cc8d7deeee848ab7765ff147bbd9b0701ad8a906fbe78651e01a74b0bd09c27e5f0bc54bd9412324a8b06806e7b290e6204ddba0820ace818ebaf0a13e9f0e1e1b5285afce899b67bb4f01546307c356b6eec98ea0e54a4e32bc427c3882526f57022f81d375bb197e5cc07f0dfb4b02df43f144da7128e52f054eb169445b88871728f3c45717f28a9b32a09ff8304c291412724772cf4f38d8438248141d6b42e3b2e92ac6f5de2087aec1fff1fd9c77f7c976139ff18ef73579447bbb93b5c8fe13c668e4d1af000000ffff1ac979fffb060000",
-		"5d7fb7a955b33ac492a013edd9d1e702": "1f8b08000000000000ff94913f6ff3201087f748f90e883d90bc6f87d6b21d559132b95353a92bc6578c7406d78763faedab90e65f3b85091d3ccffde0f275ec90ed6120eb5dc15762c91938ed1beb4cc1df76dbc5235f97f359dea8a06a45b06995335079339fb19f153b7454f036843e93729a2681f673b487dbc20f46c60ea523d9d43ab1e80dff056791ec8d60fa9fc87fcbe54abebf54afba854e2daca3a09c866b9c6c46e9b4f25a85f4883b825c447740d7fbc5837812911a5ece6747596e9dc6b101f661110aae5111f52ab4d9592b0dfa5aa1bcf43916c4e98f45f8ea8144ec90cbf2d6fa8cc80eb6829f23106703a00a760f3bbf3955b7a97b1846488e5cfe1960f91d0000ffff9156ba0efc010000",
-		"8e9bbddd194b966d47bed51495272b07": "1f8b08000000000000ff010000ffff0000000000000000",
-		"9a4861b8d7e257f1a7cdb7e282e0c596": "1f8b08000000000000ff525648f0c92c2ccd4c4a2c4e5548ce48cc4b4fcdc94f4fe002040000ffffb935257d18000000",
-		"9b3a0146c042e41f66bcae83ac7f535c": "1f8b08000000000000ff010000ffff0000000000000000",
-		"aa17e84677fe28cd94276f94d2527f70": "1f8b08000000000000ffdc5a5f8fdc46727f37e0ef501920c82e408d74ce5d92b39fd6dad579127956d81d45310c3ff490c56147cd6ebabbb9b3cca70faafa0f9b33a3b582bcdd3d1cbc23b2babafefcea5755843ffadfcd20ea0ee1bdac513bfcf69b171efd4fb44e1a0ddfaddf54f0ef428fc24ef0dd9b377ffef25b9df7c3f7af5f1f8fc7b5e083d6c61e5eab70987bfded37fceaeeeee1e747b8d9dec2dbfbeded66b7b9df3ec2bbfb07f8f87857c1c3dd8787fbdb8f6fe9e78a9fbadd3cee1e363f7ea45fa2883fade1165ba9a59746bb75fc150056f1662b709d500a7a141a7c87e0d1f60e846ea036ba09ef416b2c8c0e2bb03858d38c35fd5c2559f470239db7723fd23f8070d0d0a9d8c07e8247ac83943f81efac190f1dfc154c0bbe930e1a538f3d6a7fae9ab167bad56698ac3c741ecc51a3056301b5977e0231face58f93f7c621274e915df090fd2c1c10aeda53ef043d1160b1df02014dcb1f4333d464db7e42b20889ae52445740342a924c7f80ea392125d38bd36da5ba32a1016d31f8a15afe846f4eba81bb4509bbe373a898a4fc251fa2e080a47aee19db1acc930dac13874b371b3ebb3af5651cc8a6fe3e04a5e8777cd116d058db4587b5243eaf0df157803b5181dd273494cf837b682855e687140f2229decc6ba8baa5570ec902db09f82fe82852fac73941458c6c29594d7c14bae9303896a65eb2718d0d624fbea2f6ffef19acf3316a3f5b3a4d13b2f74439e709db0e89248790d7bd4d8ca5a0ab5145f685abafe1733aee0ca58fe2fbbba2ebd2f341be649362349b350c6499280cf686be94897016d2f9de3f0e7900b29c1deb910758f66b435ae28dffad3a01b2cb6682d36e15f5b36fc673aa4378d6c652d38cbb2a7a5aed5c806d98f1eb4f1a0642f49016fc099d61f29d21c9f08b569b0cac9c892929cf0449520a19587d1f203d04a850b4cb9dfff37d6fe5c7da1a7f09b45372a4e97d69a1e7aac3ba1652d72be782bb4a347450a2efe45c53f5b10106cc4f2aae525939093bbd6a61f24259861f5e25d0fa8d10a7a6471eb05a8d5463f057077242824738f8d14e0a76179f74fc67e3e038aa3b19f596b86278abb3925a44e579913221830dead170d8278125289bd4a9850c05545384bd1588b185662c68a847ada78596386bd602f6ce874c21aefa9fab09992be49c695d080cfa21f14d29b83354f32be498fde0c03ea463ec31e95395e97a6b8452b9f84974f086415b73a8d053ae6b221a20592a86088a4fc5e38f2a2e6e46ce8104a066bfa00617416bb8d52e3d8c9ba2b11021be98d250cb0f824d9a714d3daf89837804aec8d4d7f199bfc5d6657924675101d6acf4e1070ec8ce21c0163e5416aa12ef8fe1ca9337cb50b48a8e0d484d18214d9d1852c3f56148bbd9073c2e2202c870cd9866fd2a345358192fa331b6f2f35078c163d5e27e74bedd1b6a2e602529545345bf64c2db2109ab6f4fe5bc2f9c8042e7afe3421720e97476633c6044cd536ab42d216aee1786e2263c9a24cb010bf66ec172f501519e2a924182d94ca88eec67d2f7dc493444f38ce58795630e6059fc4107f463eb2b7b91ebe584a4a424370cde753e8efb113aa05d3be4072be8e11c02adf6a9584054e90e1dab4800a6b6f8d967545aed80bc50175b4f4a2668a32eae802a08458581e676391adbc9b13879de0aa17cbd40c67e52946175a412fa4a2b79574de55653dcb8cc94dce63ef16d02e9d1b918a4bcd25343e12a280ea62e03499949596af4a585904436172b25d235d3d3ae6017c64cf181a39e727c6c0a26ae17332c4f2ba29306ba3dd20ebd18c4e4dd00bfb99c0d0ce242a733374f2a0b92648cd9e62eb5e0c4942afd5d6781050e6ed7a7529a14f1879be7a4ac73f6646a5190933fb9373a1130ef6881a2cd6c8f8be9f16071519e9f0f711b55774706dec604239277e5ce46282a6efd6f037226074f2db6c84c4c1e0710ca53786edc52ea84cba12ac51d41d145602c294fd14081f33875fcc0882c8e0807e142a47e2d158d51c25f1116df42b0e01279ff8cf577527ec817a2e3309e5a757ad45ac405a8b4fa6267c3faff6b183a42353a7861531c78162fa0cfc0a981fc6bd92b59a28680725a66afe65401beab0e35f22f5287bbe456b90119ae9f5d99917aa3d834df2d33f177efa20088bff3e9c7485cf350e9e12cef9949caca20b9dd4350ce1ba85137bf1192be8c413321bcc2a712f6eda9608a101874a55f1ff653f18eb837f3232445a1de923234fbe1c9921b82a9d2b864151b36ab49a82a909cfa272b512b277f1d9f27efb2948294d9cd154638dce092b39595b2bf521354228735d2c91e0ca5d83504663ac96b5e9f752e73680df3b7d21df2974c8b1167b13c9e052bd78c691fc91eae01a362d85c1dc42392f3dc577768d9787a0843808fa6706bed8fb5fcdb56c66e2d638f78aad4637a9cd482c2bfc2d350850e2e846e9e9b60a0fa13a089fd52f38c30954be047a5c2c82ea2ef6ea85a07a76d1946e96bcd233a7f51d06beb60cc94cab522b1bb326b52673bec572989857a81a94afe4c31c33c2255ad7089fa3309b583a6e319b040d7f5ec3039683a6359fde8b6946bb5360aacd2013ff5942d40b6c903d43fc121b39f6550828623dd27766aed8cbbe3bd4f82fa05b35f74f6c9522c87ac4e0eed628658e81002438fb7eaec357e23a5c77741e0ea433a9187a148bb51c241290953c796e2ce97f67b7155c394e9b8f1f4299cdc7ee8b63c31c68a6ded47f49852ecc882c859335bdd41433a1f374a506047c39c24928b5ff07b6090641a787d7c5e116bd90ba4a44bb98037047a1a7b31b9667e733e7e0a828e7e6ea59c568af082e1b24865595948323d6cf09182f18461917343ac3da25cb0bb09a84b07a8d61023ca0a59b9251430e5a5f943588a4fff4b2a7a66bae09cd722cc4b691bcbedadeef366fef56e0f1d9b3dd2913e331c4d2cba3ca7c2b80e142ea9cd997dd56ca4aadab008ba2e116758e40bc685cc22a21352ebc10d18ef122dc856f517d8d754b39970d7dd1ba1c76c28342e1a8115bac04e23b73020f8adae8ef93a22269391b7cb6d232bedc8b5afc5002fd22dc1699be9c68816c67f4a19a7a982be4f901c656174c2d122f2ce666b1a3b860a9f6346d98653ca10d2ef39db4cd2bbae7943da48deda9e326f681c2ae61d785068e40ed82ad0bb733c308bd789e1c0a55f4bec4634e148a99c628362d7600b9a488a6a1ffb6d42895a1598a49da472b7d4d5254c1054e36cb10e2564c683a167533f689e42e2227214d681e9353cf608ead9c6621425d4e2c9e7dc11e0357b0e3592006e37c794772d150732fc22c97970281259c4cd24a8f90947899526d63a191447217acf802e92fc68517d654414eb19e32ed057daa22875aee35a72f7430e5c02fe7150ba4b3cb09e1acc2d98a6c51a6334faf4d1fb83705d472c2931b9c93eee1c42f7fe12e29ee1c42af3b7346b7868f5aa173ec3b7c1e94ac2535d02cb358c7cc9392e9947416d3b1622cf6c55158d11ed099a753a1400cf7e574fbffd4d54546c68a1691136404aadbe4dd6710b0359edecaeb22ae3c7b13fa394ae303b786545f5839370e681d3618564f9413a567e251818084d1abc7b9973a580c4930c56ce1660e9fb12ea19ff1381bc5e241d8b0ca3a6d59f2cae15fd6b04b2cc5115a16dcbb310ca83ed0f4620745e68f8bbcc071f2c644f4e80adee3a89db44fb246887f1a0b319ec3c3298093d25531c68a6daec5df4719f75554f09dd15cf2d9b5a3f3a61776627da486065d6de53e3a646e56e4419ecf7e536e25f7c53a71a1362473fdeb1a6ea5e3b60b2d3df7495832ce9433226bbb9f4207ccdd3bb5670530b03bb9ed99276bd5ecb888066ed6f68ad4455177673d6ef9b8f46ee9e56b30bc6b5cdd3cc2e671053fde3c6e1eb3893f6d763fdd7fdcc1a79b87879bed6e73f708f70fe53702f7efe066fb0bfcc7667b5b01cab0837e1e2cdd73be8c64ac698a11ec9c4f3c831509bb26380673712b652f20af6961b7d9bdbfab607bbf7db5d9be7bd86cff76f7f3dd7657c1cf770f6f7fbad9ee6e7edcbcdfec7ee1587ab7d96def1ec3d70c3749c8879b87dde6edc7f7370ff0e1e3c387fbc7bb508dc39e52a1a23ecf0d463bc9db0dde0285a6f2246ec430583358497c9e2fddc2c873588ec419888b596c98623a37f6dce06418978e21df995ae64e3ba07d5cf3f2a8b7dcf39e77c3290aff6d0defb361e9b5f752eca5e225fe864a33e013c531a912a468038ae7a8be4363a7726c937667de585f8e1e341e943ca0aef1baca4bf76a31299ee7487f18fb57814b386850c93d333f56ef608d73f382241dea41d4def196fe72ae044c5dd41563619f3da7241f1d070bec62d18bc3725340afa7af13e6ef14dc80b52ce67652d7b221161c56164473c2c4580a95a426e0ae3b4166420bc286cd3d55f9b996bb51f9d346994d3a66d419c32f5247971660bb983b5cbdb8984f7ad1cd9509a17b30a6394ab598487e06e7cd30880356cc1a46d2bd15528d36142aa1da51cf04880be4a56f536ad3f714c7a54dc2d1e8ae2b0e4822f4a7b3bd24240fec45f324793ddbc6ef499c93d110e9538b283f65c35fd7705353b12053243ca6c36fe6425e24c8a78eb8fe327bcf96942faef81261ad3b63c27c9547a8cb953fcf7341408b8c301508d651e81ac3458630608d8838710462afa59fb333ef8e55521fcc5ec5b116739bd704444493c35e473aae5fb12d936eb95dc235fc648ed43f852e341b8dad5a489eafc8dfd968552e5e32458f1b189e10c79f095d676c658d990dcd0b9b02e8e7b153110f71e24cad966c036a53fe87f467fbb4b37d1a6c5137e195cea8e6c2705ed89ea12951f16cc922bb476be70d5d1c4c0be7d0522ec5f96c753e95de4f918d14779ac80ab36133f93f166159f0cbac4d8ae5bbed2d55dd4b5fecc5276e3e7cb8dbde6efeeb7bf2254f1c86414df1538af25b43fa3756e7586caf0060f795af54f1ab8ee54822f3702315da41118e8756b09aa701ad44d538405d2be34239d85b517f46ef60f5eb6faba2b751a24ec5704a81c5681b5bc6a2175fc3d5add1ff94bf5c28933689ff876be08e9fdb5cd7995135d415644d624751d4f572374cb9e326edc5735ec3f26020a8b0864f0842390316c3d371089bf19d1f0e31e41cf3dbd0b031271d52b14e9bdd3dce9fd1f07e36ebe2e8cdd560250fc7099c575447967bd7f8450e298ac2c9f9b38068beb4f7cd939e7958226cddc9a71941e73de6afd3344dbfc1afacbb694fd7bcbfc51762bc3445bbb58ca4aafc9a15aee881fca5e8f50f2c233532040fa1bec5397da2fe52c73e96313307d7cc858ad981d9f3004e2ce68029aa859fa3ff8f3e997dbf797bb77dbc7bf5ddfa4d7ce96b78fd97484afc3a8ee51493baf36fb040bac5035fa4edff4fce9ec87ab0de23e2428914f4cc805a598312fa308a03c2c13ca1d5a75f22a6d1cbccf2ddf9d5d6df7ef3bf010000ffff3a551cdb262d0000",
-		"b86601b5fb1639b8db9e47d1110c69da": "1f8b08000000000000ff9490bd6eeb300c85773e85036f062c2df7056e7f50a44397145d0b46621c05b22c90acd3be7d614b098a0c05ba103ec7243fea28f2406a6163c639d93363cec476c499525f953921c3a6ebacb0b3238664bbce5ee7aaaf24fad3073330fa48b0ff08d15bd814792514f92be2327943a836b46ddbec5e774ddbb66030ebfb408918953c18175124a31ec11cd0e9c45f45649e4ee4148c906a48838091cc210d778469112aff7a87ee4865ff3629c5189e9bedc3e3ff420a9e103a13ceb2d4312e3573697f215d17ad9d36ed2bce660e332ad9c5abe75b1f4457a37e98b4ef6b4670f3f23f04f2b66bee274fe5d459dce469f9d33c5db2690e219280394454a544becfd3683ec7f81d0000ffffd2eb929909020000",
-		"cbc332645c189e3a7ea98e4827de74f0": "1f8b08000000000000ffa4954f6fda3018c6ef487c87c8e792d06e870d11aa406987e4c054c2d41ddfd85eb0e63f696c207cfb09b681b332c06a4e91e2dfcf8f9fbc51faf7b514c19a55866b15a3dbb08b02a688a65c15315a648f9d4fe87ed06ef52958c8c1b0d11254c1b02edaade0cf554ba14c8c96d696bd28da6c36a1e0af2bbe5b1deaaa886a292265229a933d2b7481fe817bb5e10dc1e6c39ebceb766fa39714cfc99249e870652c28c25cdcf09ed93fc59a80dd1fc223c851e401b9f79d8fe1e7b036140ddaaddfb27e59e99255761b28902c46765bb2902ca142c11ac48ac568f4257946d1e0ccf235540de25bf27c80ce60392fb8b2076a38799a4c3314d05c9a18c9ad7915970c96abadabc826d3ef271dff35180942b88a799a60ece7908cf2957425e9f861b248fd2c2effde1e1c5e574004bba1f2e62757c56e4c2ec92e06f125fd23fc101a8eaa473c4b7c6250bdca053be00fb3c5108fbdfaa4601d3ec94ed067de8564ce40a6fe3b370ceeeec722a5b9dcc22993779e9dc1589065e348f32c49bf7a7972a1f3e3978e67432f9ab8f4c8a1ff3692dfbd19afeb6478367dcac62f3ee3d5e01bacb9a65056dbd3b453443f7af3ff1ab45bbf020000ffff41c60616fd060000",
-		"d20aa1521e174c0941694baf26a11c37": "1f8b08000000000000ff010000ffff0000000000000000",
-		"d62b76745af14169998a81b260a1387a": "1f8b08000000000000ff6452cb6ee33a0cddfb2b88acda22574e82bbbadd247d5c8c314502d4e9145dca3223b3754455a2eb06453f687e63be6c20d71d3418ad04f2f0f0903cf959066770c9fe10c83602bf7ec262b6988334081cc892d32de84e1a0ec061fc4595c1507743065dc41a3a5763188a565e9b063f3353f88121123b58a8199c24c0644c4d4ecf13c5813bd8eb033816e82282341461472d02be1af402e4c0f0deb7a49d41e8499aa1cfc89294c0c3c8c1956872a0c1b03f00efbe0241cb283abd46c4ff97e77ddf2b3d08561c6cde7e40637e535c5eafcbeb7f166a3616ddb916638480cf1d05aca13a80f6be25a3ab16a1d5fdb01d1b106b104ea2fb4042ce4e21f24e7a1d30d1d4142550d5c9d1ce3e25523c02b003ed60b22aa1282770b12a8b729a48ee8bedb7cddd16ee57b7b7abf5b6b82e61730b979bf555b12d36eb1236ffc36afd00df8bf5d51490a4c100f8ea439a800350da26d6c3ea4ac423093bfe90143d1ada9181563bdb698b60f905832367c163d8534c578da05d9d685ada936819427fcd951ae599d7e629f1102b4bd27495f20d0bf778502d3d7754e988ca34da596cd99e6719ed3d07010e563d768e443d769e0483d29ed416a39c7f85441fc8d95dd07bec393ca98a5994601465d809be8a2a07c405b37c1467f9d970dab7a5e13a19d7a7787c1f2fbe1c5dffa97288bd8c6e9eabd9600d58464ab65ccc16ffe6f3593e9f0db32e8f7b65a6d531fee9006f59964cb81c72e9f7c254c3a8f386751d4f4ee1ed3dcbdeb3df010000ffffc6f25d68a0030000",
-		"e2c22af38a454e78330ee23ebd8c080e": "1f8b08000000000000ff2a4e2d2a4b2db2e2525028c82f2ab152b0343532e7e25256d0a510707115171465e6a5834c4e2c28c8c94c4e2cc9cccf03711514f2127353ad149472320b4b3393128b5375933312f3d25373f2d395a86137200000ffffbfa5255fd4000000",
-		"ef1db579d12d4eaafd2c204fa0f530da": "1f8b08000000000000ff94913f6ff3201087f748f90e883d90bc6f87d6b21d559132b95353a92bc6578c7406d78763faedab90e65f3b85091d3ccffde0f275ec90ed6120eb5dc15762c91938ed1beb4cc1df76dbc5235f97f359dea8a06a45b06995335079339fb19f153b7454f036843e93729a2681f673b487dbc20f46c60ea523d9d43ab1e80dff056791ec8d60fa9fc87fcbe54abebf54afba854e2daca3a09c866b9c6c46e9b4f25a85f4883b825c447740d7fbc5837812911a5ece6747596e9dc6b101f661110aae5111f52ab4d9592b0dfa5aa1bcf43916c4e98f45f8ea8144ec90cbf2d6fa8cc80eb6829f23106703a00a760f3bbf3955b7a97b1846488e5cfe1960f91d0000ffff9156ba0efc010000",
-		"f14d4ca455d8d506814c60ed3c54c665": "1f8b08000000000000ff94913f6ff3201087f748f90e883d90bc6f87d6b21d559132b95353a92bc6578c7406d78763faedab90e65f3b85091d3ccffde0f275ec90ed6120eb5dc15762c91938ed1beb4cc1df76dbc5235f97f359dea8a06a45b06995335079339fb19f153b7454f036843e93729a2681f673b487dbc20f46c60ea523d9d43ab1e80dff056791ec8d60fa9fc87fcbe54abebf54afba854e2daca3a09c866b9c6c46e9b4f25a85f4883b825c447740d7fbc5837812911a5ece6747596e9dc6b101f661110aae5111f52ab4d9592b0dfa5aa1bcf43916c4e98f45f8ea8144ec90cbf2d6fa8cc80eb6829f23106703a00a760f3bbf3955b7a97b1846488e5cfe1960f91d0000ffff9156ba0efc010000",
-		"f260e3f66de174069b568ccc5a65c9e6": "1f8b08000000000000ff6ccd410ac2400c85e17d4e316b4117e2c24d4f22250c6d06033123498ad4d34b8a2048b7ff07ef59ef518612b610c0ed30c274afe6946d8976bc02e98cbda1b052198a346075b2c0c65a05955e5fd9165867d2408f55b2f9b34ebfc8ef6c97bc39ad0f19ffe0bc41f41df9040000ffff97f4ecc1a5000000",
+		"19f0f1425f9f946c895558eb80d01d1a": "1f8b08000000000000ffbc965b6fe23814c7dff9145e6b1f66a4c905a65aeda2845105544203b42ab0da794226394dacf525b54f0a9955bffb2a09b421bd4c2b55b40f89ec73fefe9dbf9d83836f3b29c81d18cbb50a69d7f5290115e998ab24a4abe585f327fd36e8043143b66116862953094c75d221fbbf9d14ca863445ccfa9eb7dd6e5dc16f735e06bbda24de4e0a4f592fde4455aad0093dceedef2c3fcadf7ead127bbedff5fe994d17510a92395c59642a8246b6e57d5b4d4e75c4b02ae01d180f3aefc869be3b67ee5feecec674d0a9a482df1ca7d2b27dcf8b75641b6a91965e2de2609181f5145884d84196582fd22297ca4d510ae23807b53a7c0148781c529099d005c0bae7f7ceba7ed75ffb7e97129663aa4d48b354a3de424149bc9136a4b2b0b78292482b841d863486bb2f0816bf5864095022d806840de95dd7f55d9f0e1ebc08222d25281c4c1447ce04ff09045320c83602fae480117887b84e23d5004358969175fc9c497844a7c48064e65f1bd2f161e871e1fde2a5134455793ca6a4342ba4bfff573edd0d4fb8c2fb4f3dff7343ebca70c94c41be8f7f547ee8898a0c943584f48609db5e64bf90b26818576849560b7c8722a46872a044e54294fc0701af85b9dfb146ed4fe96b33d69be24d450ccb686d7e85fa66b09642832bcfe27770adaae85370edfd422ea145560e596432bb6f1b06a49c3b9d696f85ab5d5b96d147a287ff186e582ef06f2672186a99e508714887abebebf17cb95e4e66e3c5f27c76452ee76475353a5f8ec993b913141d830084f647885c15f541699e93511d4b6e044b88dfe78a23e9f60f0a1fc5fa0aeca1c9ac956e01df3113a5ccdc7ffadafbfc4c0b2273ed9ec04c6d92f51b1bdaa54998e23fab1f3432199d08aefc0a5f72ee8fb317f9ca167f0242abcdd3a3f8c2ce2eb4c113edaa4586605b58d5961e39b64086b9fd309e5780ea255b40e515a0d99eaeab970fb727f01a178006652075cc6f8ac5ad38ba9cb4e40c64824540f6cf90ce2e47938b1f6478395dcde6a4d181c943cf7dbebb922dc7f4b5fcc7063b1a5f9cafa64bf2bed6db7022f01e8aab0783fd356f0138e804de936bf3a0f3ff0041f9275b720b0000",
+		"1ccd0ac777924bb29f67e89c0f400ec0": "1f8b08000000000000ffdc7a6d73dc3872ff7b7f8afeabea5f91aae8b16f7397e4765f692df97612efc825c971b6b6f6054836671083000f0035663e7daa1b0d121c8d1f527917fbead6334336faf1d74f806ffdb91e54734078a71bb4015fe4afcffcf977f4413b0b3f6c5e57f0afca8eca4ff0c3ebd77ffee24b8718871f5fbd3a1e8f1bc5c76c9cdfbf32e9a8f0ea05bdf8787bffeb035cef6ee0cdddee66fbb8bddb3dc0dbbb7bf8f0705bc1fdedfbfbbb9b0f6fe8eb8a9fbad93e3cde6f7ffe40df30813f6de0063b6d75d4ce86cd0be1e64224ba807050c6408fca423c2044f47d00655b689c6dd35bd0390f63c00a3c0edeb563435f57428a9e6d75885ed7237d0f2a404b47620bf5040fc84f07f813c48377e3fe007f05d7413ce800ad6bc61e6d3ce5cbf9678c356e98bcde1f22b8a3450fce03daa8e3046a8c07e7f57ff17942e7dc1bf1a022e8007baf6cd4760f71b16cc100ee95815b26fd8c89d19280cc3d826a984ae6c2b6a08c11322e1e5018d418d2d18db3d13b5381f2c41c7f30cc7445d2d0b7a36dd143e3fade59a1240fc251c743a2c354a70dbc752413c230fac1050c8b566783671b5d08950b3674804b7d955e7547f415b4da631389096dd3bf2b880e1a3506a4e7844afa8935e0a15756ed918c47e786b1390863151c0fc8e2d7531253316d21c29a396af226e7e152ebab64d070d00351ea74172718d03744faf22fafffff1531d63b8fa2f84c688c212adb920dc241790c99a2be821a2d76bad1caaca9177c2e26ffcd8d1770e93cffcb5f5c9556579675f2a4db91687928fd4308e067f48d0ec4c880bed7818040fc8ced9b9cfd99ab3db8d1377841e1d59f7adae0b143efb14dbf5204f6ea131dd1bb5677ba511c55d9c0da36666455d46304eb2218ddeb882dd931b82e1ec9bd021f088d6bb19a638f090999f44095e3bfd3fbd1f3efd06983057cdcd5ff894d7ccebab253face63180dc747e75d0f3d36076575a3728044af6ca027557628fec6c8c70e1424f530b96a2da0d03811b371fda029a01c332762eed1a257f4c84ae0ac3891f429a1772075b187428fad5610a7a114fba3f39f9e81c2d1f94fe45b0987c8d39610d0368b310740529d88d5ab16413d296d546d72fc17b854119a9203362c423d8112320bba5917758333bc254d610b9ad5aa62a4dcc21acadc0a894b65013fab7e30482f0ede3d6979919ebc1e06b4adfe0c351a77bc5ab470835e3fa9a89f10482161a511f2003ae3bc0e447aa194749019af5520e3590ec596ce20eff7ae4f584547b163522c1c0fba39146080ad8ece53b87b7cd26c4af262eba2c409a051b5f3f993f3d9cc65340931ca7218d046d6be82e3c1192472e0bcde6babcc199b3fc763a1e6ba55f85770aa3ed11e79b3d88ec94bd6f0d82b9d9341c04179f6148a2ac6bb1e3d9a098cb69f5871b5b60c3956f578958dae6d44dfa986934476fd95529f3145da41d72d567fe3ec9ce3cf5afc3406e6902dce9b1528019773e9cc07b9cfca2624a36aa512c994482a159953fafd4bcc2fbe450ab044421933099530d6bd8e021eb9eea0b84c60434e9443810faaa77385881023cd4f5fcf16a21c2e540895f978f2f71a0fca74e0ba2f172fdf97ede16296e942689185c202cbae0334d844efac6e2a78425f2bc37e74f4f49ee5e263b4a27da028c85cb90298287e3ce8189660614008d5575391905a9fe16cc113f44a1b7ad9e8104355a6ac5c33419842c43e9410ae431811a8d4e01c294f24f353e64bd5ca5c6b954aaf0a18597941a16d5276ab433306cef27c62cf78296524394855a426fc9c95b09635fb63e36c187433ba3198097ae53f613b8312554742a7c5a0f796b15f5bb611d13cef890456173b17414119ab9b8be7217c525fcf62e708fc66c9532a90f0b13f39140e2a408d68c163838ce4f5b4a857d9560885b10ef8f7116d34746ce3fc40288d2d17bc45f82520fa61037fa3f29d0cf266165fa075030f23a797ecab679b9922cc4a5446d51c16119c0782907a4a551cd705bfb9111455a603c65199ec7e47e74d7bd4545259675fb2e5837ee28f2f9b83f27b6a9cdca44c9c5e761eb102ed3d3eb986805c882ca694fe8f0eccdd16563078427e7c8e740b9c0f636d7463266875188c9aaae59b013d457f459823f4ab75df5696f9331673b1fcecc433e99cc2a54f06fac7c240ef1581eeff01eb5ce2e70687480116620e4606df90badb2b1892ac85f57af5092b38a827429f163343dc47bbae434f260e684c25ffaffbc179aa396cbbe08014ca5215926966c94805c946f954350c86da4d67cd44d4e381b04b586b8cd27d100e0be1ea291129b53be3a6c50643505e7374765edb7dee6850e7dc37bfa99dbd0c57a08cb3ec1ddc00f6b5b673551f0fa8fde90b42463a5cc9b6d14991b7664e8e38aab0e4ef0d6c3bb2bfd0d136441dc7384b6e74d4fbc482da2bfa9913a234ee974bc29a6b6bef4278c90a23311a3752fd943e6b0b0a8c3a86514712d5e09e5e658d65e6850c01ea8af3af021c4581301ea4235fe834998af353162bdb837a1a4e62a9fd9a85669fc855686e46255272a3b1c498a4bc5c55a5ec40004ad6cbbea2a4ec4068559c9d6fd6ae0edc27b6090afebc817b2c27431b3eba57d3826ca728d4b841e7da261ffaad2a8f829104a78e6dec2b4e1cf43cfdd7cd19795554a620fb1292554b2bc4665d5cab478c742c74ce187724c32fd8f563ceb397ea2a493a86087bc26a628f439ad2a11e34da386b52c49377e97fcf04559ceae78a4f2cf61309319f591767a6c1cd524a531f45fd3b6142a33cb99077bdb6e445a97bcc6e427f09e26697269ad4ba53d8b3e044677d72539cec312a6dab5c378b7a73e9491c9d0a571c3c1fb838444511b6143a95787745b0d822d54d55514cd05f15977013d9b8893cc7cf29a4ae2bb7849e990633d73a9ead0ce8494c52278934281f97c4952bf85341d74a6baf60bb30204533065070b1bb7bdcbeb9bd80889f23db8df28e9c41257771ceac28aefe6708383dfa9c66d93b0b52b9f554e051b5941f0aa7c3b36a25505234e72dc808a8313224415884ea7bf45a9039afe1b37a656753110caa10c1d90c1b73ef5d46eb60a809fe3163afca3c2eba5e34b4f2aaf0551e7e2ac17ce5643947d2dff5000a74b7e00ca5cc7d9ef19ea3ef7ce9a433fb52eb1553ae7a3a4b818f5b47ca91e0f3097d82f178d0be7d49424eb36d2ccde78c99400d032abf8147ee3ab8970ecfd55cd89b8b07ca28dace433e658ae6952a94353b125b8c585339035bd2866a5bfab7a77ea7f4c8824a665d34f43d914018e3ec3ee876e53a9ec28ec61b6d8bb61dfb5cb6ae3c26030be978491b05198e3c56701e6228733e98785a05352b38443f9efa5f52cc97f6166755b4741584e969589f0a8093c157610a222272cc7397d406b69aaad655957ba6821732e75746898c285e4ac2e7dc544bd8101982d8f3ad48399dcbba4fd2d1d14262cdc0aade588a522932e6aa9b66c95c4a931f092152fb22e76927b032c85fb8d9914d0081465905860d7cb006432056013f0f46379ada5fa6b830910520f94faac86298b594c15f1e5d09a17ae213c58fe7663c957a75397dfe9fb4665266314e170e9348385f50d8c8fb3b17e9a5797b4327aadaa5a68cc276cfcd37a511662d8c03fa802dd769ecb7790cb1f02ad5451a90469c5d54ed3d26c79f2442b823c3cfd814104fec8844ce83c7bdf2ccd9b3de437601ffb481c75c800482c54521d03a46ce987ad362234400220bb554bec8e134a90d454543532ff44f34d3978fce83f8707a383b6de6387bcad2a67afcfba8657b44093d38cb299d4d3a86e87ae527dae9929bb4181aaf6b3185d04a93da5578d0cb399ab2dd241b9c49014953ffbc811b1db875a2a56d071f9527bd4c7310ccacd272831a589e8b8051c70506d88adcbc2c53b06a163fc77e5858bd245e69a42304661b964fd3f87265dc2b9ac62a0b17d70fb07db8809faf1fb60f59b91fb78fbfdc7d78848fd7f7f7d7bbc7eded03dcdd976bf9bbb770bdfb0dfe6dbbbba90035853145b7a7489f25d18c2b6d31265d2288e7a4521c50341d93aa349ec49190721d3c6e1fdfdd56b0bbdbbddceedede6f777fbbfdf576f758c1afb7f76f7eb9de3d5effbc7db77dfc8d1015de6e1f77b70fe9fac0b5d0787f7dffb87df3e1ddf53dbcff70fffeeee136655b4adfc119da2c780c83b3419351c89b5aa488d376ed2e6a18bc1bbca6f29c05ee60e45929c7d382b876bff62715c2d873af2284bc0ed4f184e01a3db7c9c493cf7b56de03978bd6e7cd6cf2bd7fd9c0bb59a5f4d23bad6a6d68f70b5bea0e009fc877898f44c33a30bcdf8d07747ecaa62f3659d1f9588e0c2cee8ddea36df0aa9ab7ddcb7ea9c4f1f19bfe7ec978c3f7278cae796dc9d9754ff388796f918f8c740321f076fc7c7c24605fa50f1aca649319cd07cb44804dab7ab55fcff0e9ed7c2560b91c1006a4dd7a964cdb46b7b44a4eab042a60d24c971672423423344d445513695cedd3ce9cb2b8104a5be3d3469768ba71c618422dce7462cc0257854c8280af2d2266ae486ce392c3ee9d6b8fda94b3c34f10a21b0645335caa094662bc53da8c741d8176f2a61bed52dc287bfe26086d01c8794b7da483315c55ec8754a0aff3cd0c1af3305db54f9a97a49d5cdf08418b12f2e506219f22e0af1bb86e2827901632f2d2c9d74ba22e82e2e3814af775b80a0b73d07e651053cd55687370d43bd3329d6ac6ac50d20cd97f4f6d66878c271528e650d986033b8c431a830afa4dec77d85bba5a2274727481c9bc83ab8d4ca1b86e7945b0431383b46ad1812aa6dc5fe9dcbb64b4805fdc119fe8260c3555b3c2d81a05e1453ebed162e7887476a9b9652d42a2e6af09481718657eb9d259767f994e574c8a0a37909930f54c9a279d29e0c9f14537ddac9b163bb46d7ae3e04c7b6674ae7ccf48948beb598b4242db66f47ed996c9e45885809ec24786a8d5f3b9713d49b121945c07136960d1e95ccc67f396fb5d65165e9203dfee6ee0eeedd96b70fcfbf5fbf7b7bb9bed7ffc4826247d10a2a6ee717d758f7e63568ef32e89aed77de70b15b56cb482584d138448edb4413f1842ebd47eca0c921fd668da00681be32872eb096ada52620c70f1fb1f794deb91271392eda6ec4c8caad2f5159df4062e6f9cfd87f9be8010a1f332f1ff7705dcadf360231cdc685aea2d673ea43b28d2b61061a4b211c264a3fa3c2f423b4286c4c0063e2228136841959e96396946717e962a16a342e08a95bd319599434ec679b55a6316835cbc40f1402f5e0c9e76f72d10065f5069bbde7c2685339ba8829ef7f1a2396929f338a01c7228df1c68639d1c6d5926fe3e4dd3f407fcce7cbbae388b6f92fdc18f8b93e4bae1b9fb54e58550b8a407e63b97573f1189dc8f1010a4f425e3f35cc66b5ec60f463334ce1e2554b899ca78e46a9e479673e2f9415031bbfbb7ae9cbedbbeb9dd3ddcbefc61f39a5ff99e0afd0bb579be73f6a29c52aef495d9d361f5c0972af0ff65f99d0b6f56db03e28a85ece45cd674ba01a3ec7e547b84bd7b426f4f6ff6c9b464a9d7971a14e19d6ed006dcbcf8ef0100bc045d7b5d2c0000",
+		"1dd8b89f38069e42fb7bf0b92c84b1ff": "1f8b08000000000000ff6452c16eeb3610bceb2b063ebd17b8946df4d45cac97a4a8d0c00e22a7418eb4b496369149965c45310c7f507fa35f565056da18bd2d77676787bb935e25b8c28d7507cf7523f8fb2f2c668b39a42158cf351bdd4277d2580febc728a80443df3d97640255e84c457e68ca9c2e1bfaac4cf107f9c0d660a166f8160193b134f97e1d290eb6c35e1f60aca00b04693860c72d813e4a72023628eddeb5ac4d49e8591ac87f03a212bc8c1c762b9a0d344aeb0eb0bbaf40681945034023e27e49d3beef951e042bebebb43d43437a9fdfdcad8abb9f166a36363d99964280a73f3bf654617b8076aee5526f5b42abfb613bb527aa20368aee3d0b9b7a8a6077d26b4f5169c5413c6f3bb9d8d9a7440e17006ba00d265981bc98e04756e4c534923ce79bdfd64f1b3c678f8fd96a93df15583fe266bdbacd37f97a5560fd2bb2d50b7ecf57b753104b431ef4e17cfc81f5e0b84daa86d51544171276f67cc6e0a8e41d9768b5a93b5d136afb4edeb0a9e1c8ef39c4ab066853459a96f72c5a86d4fffe1507a589d3e55be4391ea11ebc7da552d4c3983b9dae9384f7ce7a81f5b57aed0c8b7aed1c0b79a51dab0d05b9fe0a09ceb3a9775eefa9b7fe4d6dad1525144495d6087d882a06c40f6be5dc9ca457c3298fcbd25684ccb9980fa7f1c2cbd1e5515f760e4fb186e5fb68e1b99a0d7ec03270f4e262b6f8399dcfd2794ca6c9f2726052b63a847fc7e09824d179cbf81aa277cb1546b1f75657e1db771c4f49724afe1900697d8d2d95030000",
+		"1e377ae0ad7454dc9c4c42aa8998d40b": "1f8b08000000000000ffc454cd6edb3c10bceb29089e6ce033f3f3b53d187581344d8b04291224692f450fb4b45236a1b8ec92726a087af782922ccb69931e7228604034b9339c9925e94c55a0f5a24e8410e24eaf743bc06c22890be51da32d72d6253c10dfab25519053b102f64856c8ba16ea92e90ed2a0aedbdaf744e16bbfdc34726043eac954060e6c06365dcf4a6d750125d81dd203b5af0e5eabab93f393a3eb932d451464f047854bed4115ac330363dca13a54fb326992a460aa9c58eceafb14274fb356d506f3a8e48803e63add3190814f195de8cbcf3702447aab6d01860ae13ab8c8894597828831c8248981f6d97aaa3885632a9d0eb84483612d16e24caf74bf9b0ad48f263ba2462551d0341a6470e43110236c7a57ea15d863b081b599b445f033b0fe26b791f5aee5f7c7be0753a37d926468d476132c9d69fba5631e4f9f917937398be3990f9a03f0ec019672fa729abb6c993ec333d89d0fa3594a0c729ad4f54c602ed407c615b0689a96842b1bb0840b6bd65df0ddf2e8bccc47b39b23d22ed4f5884f6dc31b03b6b3752dc046582fa5fb93b422522a1d9a5e444cb53f5386ca25ddcfbb4fef5a5b4b9de34ba614bc277e16d38202f870fa92d823c1866c48f6aacbeeafc9ff19843627e530a5d4e07cf37da5dea8ff9f82e468c04fa4e774afd468f718ba6be5e5f4a9f6febeed3fe8719324838efe2ae934e00a0382570c05fa003c91d1949cf615f1a7b9a862c7bc588852bb8b7c322cc59f1c1ea18f68408a40a23dc29f75e43b1e5ea8a691ffed0233c8756582dfe20681ca3139e0284d0ea0ae21c38d39471fc4427482e36313b4bff7ea01c3edcddac1db1bf0e15defa3f270f6c562b8343ae4c4e5649a34c9af0100bf0977a079060000",
+		"1fa80dc3a6324f053e7ab61d22dcb908": "1f8b08000000000000ff94965d73a3361486afc3af5099de82b26d2f5a0ff64e6a27339eb113ef1a77daab1d599c805a7dd848d4b83bfcf78ef830b0b0ec869b04bdcf79c5393a071cbccf0547ff42aa999273f79d7fef229054454cc673f7103e79bfbaef174e1011438e44c3322132868d8a1d545fb9e052cfddc498d30ce3cbe5e27376ce98857d95c638171c4b8da3232d43b98add7eec2cd7ac177ff9b90cfce9fefe1dfe73bbd9d30404f198d486480a9d68cd66ba14378a125326f086c7b8f9bc21a6fbbff78bff9b9febc85d38a555f083e7955e7a8671a4a8eeb851257065e299eb093496a00d449e21b1c654f14c483f318223cf6bdc2a7c0f06b168ee7efeecd96a217fd92cfbeb15f28ac275eeeeeeee486612958e620fa5d4a2d151e80af4578c70a0a6d5a892067233eab3acb416e6e4085c8fb29b526a516bc75ebbc4cbf16fa0e643a60c93f1dea4c4407c454581d4986037f99e688b818c50512c6ea73bdc7b9702553262b663342a8a0efa9d9857efe2dc7609a81202a459ac25338c70f61f20930032e4c861866e350aedbdff4c04d8ea04b809eb3aa5400c9460156ee9b9fb150b17a52048fa4ff720ca587f5959db7ddcb61c759ea9cdb2256d03ea5e424da2fe2e6582a4d78ae996e146fcce62264d0d78a3262193d7b53413267b41389f46b610b14c4c333d75283f7145a6c2572a3b72987258016582f029649990b4d507f21f24a5d34408f964162b623acf38904326a6641b6d7ac890b116da10719af0399c22dba7df229b51695602dc69f0fe98f69a722d23c8a1df94f56c94d2d86cfc38180e66d12f06a85533c9ce592df987f266745c6e8fd6999446ada7dfae23596f548d212a0a170fadbe528f32a985334276d6107b45706edfdcfef6baffb0e9d90915b1d7ebfecc51f5a617577de65f6414a470e28402aaffceddedcb6afdf4175abe6c0edb67949547fbc9760932cd01b71fdede756126998a0fd7dbc77df8b0dda1d5e3d3c36113a2e5e1e3c7c7e7f053abbc3ca3c36ef5103e0eb56e05037c4b6ee18c7758507f66f760164e80073f5b16ceff0300e98be411f2080000",
+		"22bea56d399aca323625c5f2146ab078": "1f8b08000000000000ffbc57c96ee34610bdf32b0ad6c502687272654e83c487ac87c4f741892c913dd364b7bb8a1a0b06ff3de8e6224a510cad39595dcb7baf1e8b307b012f15c15a6962306b908a40a8b61a8580499268112d8073632903ebcc57cae5d134392d632814eaf10896dc1858c6b021c7ca34bb54490d39142ac6d4128c03c195a65d51382ea30558942a0b5a4c2bb6951088c19146511b0231f364a11ce562dc3686ef4aaa90b21a73aa8c2ec871b40080f7f7417dd73df6a0c91058c6f0fe5e230bb95dae3f8794c5fc1b96d4758f1e78221bddfa15370843cd321eb80627bace030c13f7870285ba0eb029e27efcde5c308ddefa7c8875dd8ff301356e4d2b23615e6153923625c3da38c04019dac2f866b02b5a80a3a62097c1f78aa422b7ff70150f792a62ff2c72631515800cca3bb66a952e32a871438d4f970e0b4d71c0f0db028a670fd50b914a71df16868916e33e7036feda9bd5238d158f05adb1d59241bde5571d83352ca5238e815fb5125a863d7cd91f9f735cafcdc037a9e1a07130dd1b3df8ec557312853f5904f0347991f5b2390dd3a6d6d4c95bad13a9ad8e00e060fd43c83b9cc1c36cabc6b687901fad17d75208ccfd3c4ede3b9c86c2a43f24df84cf9571d8ffb19ebeee43414c22aa29f90a4d4720ae96a5d56bab56c8e4df634b4e149dadeb18c605c22698a76939d3840a25c6e5a659abf25c5d7bcd0fa7b0954a50c4a9552be7dbb0df7d2a9f2a1be3e812aebef3149edf7ff9e9f9cfbf9fcf2519da4e61f8ebf9f3cf7f3c2775712ec7d4780a0bbb3cad5135e957dc60fad9dac4ff3897721f65f69f69423cbabca788136299c4bd100b5fac700775a070077bb1cc30bd2336adcb8953b456ab1c459926d9d6fa623fff13f10e429f0adadc5eec887a0fc1fe81de41f1087b0fc9d699e20e9247d85b49664151799afc46646f20750ef77099a2b1816f26ea00f1425d53595a6ab342bd0b247d202950d03b9ec8d6125ff2017723daab27ecef1e472718be97f72718ef2eb7daca9912ff319e4ec59c6e7e483e259f527ac3da6afae291cd96e84b08dfdcf3f3e9aff67ebc771c61bdd50b713ad9d5d3f477a7ff65968fa90e27999585cbd9d1d509997feff43f03007e543e91b2100000",
+		"2c88c8cb98ff3809d8a828551a6057d6": "1f8b08000000000000ff8453cb6ee33810bcf32b0a3e258157728c3dad2f561e8b1536b083c8d92058cc81a6da524f64924352510cc31f34bf315f36a0ac60c6c80003e84034bbab8ad5a5f442e002d7c6ee1c5775c0b7af984ea67f22d404e3b8622d1bc836d4c6c1b8e1e413817eee8e15694f255a5d92eb87322b554def3763fc47ceb3d19826139cc586d170353a9f45889d69b1953b6813d07a42a8d963c30d81de14d900d650666b1b965a113a0e35c20f82a804cf03865907c91a12cad81dcce6e746c8308806803a04fb579a765d97c85e70625c9536c7569fdee5d7b78be2f68f693219861e7543dec3d197961d9558ef20ad6d58c975436864d7bb5339a212c144d19de3c0ba1ac39b4de8a4a3a8b4641f1cafdb70e2d9bb44f6270d46436a8cb2027931c25556e4c538823ce5ab7f968f2b3c650f0fd96295df16583ee07ab9b8c957f9725160f937b2c533fecd17376310879a1ce8cdbaf802e3c0d14d2a7beb0aa213091b735ca3b7a478c30a8dd4552b2b42655ec969d6152cb92dfbb8550fa9cb08d3f096830c7de9c3bb22512a8495ea2502edf748ee9df94c2a24f743ed709809c15b6b5c807155e2ad635d6d9cdc5267dc4bb2362624455fcc8eb647aed96f47641b8c327ac355eb6800b832269c8088f4a2dff17eae4c49c8ac3d0c5b9f0fc98f92b3e3f110ef307f1d627d994cfa8c60ee39e633fe3ce9e524bd8cc554cc7f49296cbb6e584135d2fbc887bd10319643dd472b155e0d97d84ad667458828ff7f8274953fc7be6f8edf074b12d7eab3ccdaa4c71e1f076602000e421cc4f7010052453ee5f0030000",
+		"31892fcf485f3caa6a4a8eb3660bc8e5": "1f8b08000000000000ff6ccdb10ac2400cc6f13d4f71b3a08338b8f449a484a3cd6120e6244991faf4922208d2f5ff0bf9acf72843095b08e0761861ba5773cab6443b5e8174c6de5058a90c451ab03a596063ad824aafaf6c1f5867d2408f55f2da9f75fa457e67bbe4cc697dc8f807e70da2efc8670097f4ecc1a5000000",
+		"391243b40806a91edade2b3bb340370c": "1f8b08000000000000ffc496cb6edb3a1086f77e0ac27b91ce395d1401a3a017b44891a001d216ddd2d458a623912a495f0ac3ef5e8894e49123bb4e2f6856f6f0ff87dfcc908cf9f5a62cc80aac53465f8d2fe8644c404b93299d5f8d3f7f7a97bc1c5fa7235e59b300e9c9a62cb4bb1acfbdaf2e192bc50a3415959073a0c6e6ecfee31d7b41277596a0bcdc38d5a9d7eb355dff1f74ff4d2617ecebdded839c432912a59d175ac27844dabf8d53972eacde1a297ca0fbe9aea456b821c9c66531980421ddb86c9c8ec276bc3419145f620bd2b0cc592fd6e82a6141fbb463e4b935cbea264b8dcda9abacd2f9cc8a12d6c63ed2a9319eb356b1f708ebd54c487f93a5d192d4cac479613dd824eec11992edbdcd98d2ed96d0fb3810fa1092bc36c637b464b7e3ac55062f672df9a8878dd3bc8fa0c1dc83c6c0d8f0aa0b07cf21ef206beb19248dbb6951c2a97dc27a9466e0a455557d34d25bf56da9a6c2019173a173284c4eda233b3396c42e91ba4d9c6163d392a52d58fbb95012b4038786d68458531bdb4b5a941514a6028b4d5db0b361590c39592287345a83ac0b624379de0c2e7b91e3afb192fa3367217b73766d8de755afac8558093a34a80f6225f0907ac2bdbf68bb3e98a49b09cef4d4d2b062c0b6a915e80cb4ec4377e1effbe0afdec6736ee41aa647ae23c328ff0e70914de55f20ec26750656a74da4b1700ecc764bd48cd0b756adc092ddee1970f5418dbee3afd61024f21d3c2a1877bb4d1019dd9fdd7e5e7ce09f48d1ab56a7039d3df13b692a48ed527b55427d53ebafc77b7590e2bc09360f6061caa9793c678a8d10b76398e899207ff0b07b7047fe3ba2b646d1c99e1e44d0b3335daa02a5e555b1cc95462f108af683bf53f7a9dae3ef96c871a27676c8c4598f9db3a6b4116795350b903e1dfd18003d738e28000a0000",
+		"3d6f7271175a241c6676a0e0b6784c33": "1f8b08000000000000ff2a2e28cacc4bb7e2525048492c492cce2f2d4a4e05f114144a8b72ac149454aa5d1c431c83fd43839c5de343837cacaaab15f45ce04af542837c146a6b6b95205a8a538bf212735331f405bb06f939faba626886aa47985090585c5c9e5f94826e4280637070b87f908b55ad12176000eb76a431b3000000",
+		"3f45035f9e4e08d07dea06db53882aad": "1f8b08000000000000ff03000000000000000000",
+		"44547f82f1c14c3f4dc1220222361dc9": "1f8b08000000000000ff2a2e28cacc4bb7e2525048492c492cce2f2d4a4e05f114144a8b72ac149454aa5d1c431c83fd43839c5de343837cacaaab15f45ce04af542837c146a6b6b95205a8a538bf212735331f405bb06f939faba626886aa47985090585c5c9e5f94826e4280637070b87f908b55ad12176000eb76a431b3000000",
+		"5bf858642f8b603a2981417774af8e20": "1f8b08000000000000ff03000000000000000000",
+		"67950aab6673648c69c9e937ba6f763f": "1f8b08000000000000ff03000000000000000000",
+		"7432ab9e5a25c364f38dd8b1f60f0313": "1f8b08000000000000ff94d5cf6fda3014c0f13b7f45e4734968b7c3860855a0b48be4c054c2d41d5f1c2f58f38f343610fefb896804a7055af754897cbeefe15862745f0bee6d69a5999221baf507c8a392a89cc92244abf4b1ff0ddd8f7ba31c0c64a0e9740db2a058153defff5f2db8d4215a1b530e8360b7dbf99cbd6ed8e1615f5545500b1e481de41969285705eada61ad59c7efbe34f06e30b80d5e12bc246b2aa0cfa4362009b5b46643dd7c881501d37c018735da8e83b1ffef7ff5bffbb5ced1b8d7a44665a54a5a99bd2741d010997d497db2860a795be01b1aa2e98fe81905e3cb4f6fa1ea805fd1f3d15c46192b9834ad99c44ff13c455e9e091d22b1d7affcba374ceeed401acf7f9f2b5c0c68019cdb85651261ec941034671b613792d943bc4a9c22363f073f7f04965615104e6f7271f397c9e27035aea73e5ac215baceffc3159c428f781139ec90ab4dc669ab1f16ab099e39704a98007ef2b3699c44f85de04a018c353e4a1d861b264e348d9399e3d88eb7479fde80d01f2d7136e4b8ccc16b03a26c2387c0328d929f0e958cabac0d4cf062e260896da7963d1e4576f7f6427eae8517f3a774f6e270233bbc438fefe2b235b436e76d7b04a3e0dd0fdbb8f76f0088332f1214070000",
+		"7e56aa830a76658249dcb8d51af22e93": "1f8b08000000000000ff03000000000000000000",
+		"7f39f553fe51e94e5881fafd200b2656": "1f8b08000000000000ff001800e7ff2320604c6971756962617365206368616e67656c6f67600a0300b935257d18000000",
+		"81e8eb5ed888c2786153702c19918a38": "1f8b08000000000000ff64cab10e82301080e19da7b8a0b30fc0d6089b8a69218ea6298790d03b726d35c6f0eec6e0a4e39fffdb403320382642174726e01e66e12eade5d863805ed8431c1090eea33079a4084cd37397855946ba15194067a30d9cc4e1a700924c05e4db57a91a65ea56efab6bab0f4bbece8042d6e39f30953ea963f565b30de1c1d2fdb2b332e652eb72c9b3f7005a65be0ec1000000",
+		"a15dd157ca9616932f4a8f73b77ffc95": "1f8b08000000000000ff9490bd6eeb300c85773e85036f064c2ef7056e7f50a44397145d0b5aa21d05b22c48acd3be7d615b098a0c05ba103ec7243fea28a741946087e31ce89c38464934f22ca12d0a4f9c60d7349493a1915da0a6a1eb5cf155b2fef40187c4d60b741fce5b82dd26af844dfe8ab84cde108a0d755d5787d74355d73520477d1f244862150b683ce71c598f803d1b9dd2d726629a4e6214308baa0b4306cc31b930dc09874568fed71a3647d9f6ef838af7eeb9da3f3cfedf48ce0a4383ee9c973afaa5c6b4b5bf88ae8bd64e0a5dc1514c6e6615020a5d399faccbba1ae50343d7968ce0e6e57f08e4ed50dd4f56d60370ce66b2b2fca99e2ed954bdf392017bcfaa12c4b6711af173f4df0300d2eb929909020000",
+		"b583be067fe923089841f9549fc9401e": "1f8b08000000000000ffac90c16ac3300c86ef7e0ad19d9307c86dac97c106853d816aab99866b6592121825ef3e1c9791fbea9bfdfffafedf32d28574080093a80f70bb417f52f9a2e8fdc7a69d441dd6358427e8fe7942b049b98c350ea72973446729f50a50f04a031cf6059ed5f982d15f13aceba17654b970266b13189d973a9368a96a4247935923353d292fa45dcc68d6edf0c73f5f7fdc2c2fd5718fc8fc3df319edcea082e74c6900d799b697f88965a42ecbd8703f78cdd0bfa339e99bb41f6dac472cec77001d2f323b9f010000",
+		"b7203b786f1daff425d1b72acb1eff57": "1f8b08000000000000ff94914f4fc32018c6effb1484fb60530fdab45dcc929de6c99978a5f49592bc85da97aef8ed4dd1d5359ec6893cf0fb3dfcc977b14576869eac7705df8a0d67e0b4afad33057f3b1dd68f7c57aef25a055529827da39c81a3372bf63b628b8e0ade84d065528ee328d07e0e76da2c7c6f646c513a9275a5138adef0259b45b20b7ebc4fe0dd66b395ef2fc757dd40abd6d651504ec3154d36a3b478f45a8574811b8e317b6e60aee7eb07f12422d5bc5c25556e9dc6a106f661110aae5111752a34d92c95067da550feb5fc04e2f2ba227c754022b6c865b9903e23b24956f0b99f38eb0155b06738f9fd253da4f2d00f302972f9efe3caef0100ecca597af3010000",
+		"c431d1fa3be6abcaa94f1c532ea4382f": "1f8b08000000000000ff64cc414b03311404e0fbfe8a819e1bef851ed646a4b0b5dab5782cafe6d106b2c9fa5eb23d94fc7771c18bde866f8659a0f35fc59f4919cf422e30c6502e3eae601e2e33dc504647999b05deaf8c91546f49dc0acbe56f5e1b639004f9cae8b66fc7ed63db3f9d36fbddae7db1a7d7b6ef3ff6070b8e939714078e191389a773e0a64858dfef309632692af2c9e678e8506be3c44f2c7f4b3beb2690eacfa8284ba481ff7d284ba481516bf33d00aacd976ee5000000",
+		"d85e378becb2fc1ffe9bca2b9232169b": "1f8b08000000000000ff002f00d0ff726f6f7450726f6a6563742e6e616d65203d20227b7b202e50726f6a6563742e41727469666163744964207d7d220a0300abf984ab2f000000",
+		"edb0a9ca7fdad246e78a1c382185ef6a": "1f8b08000000000000ff001200edff2a20746578743d6175746f20656f6c3d6c660300c8b6eacc12000000",
 	})
 	if err != nil {
 		panic(err)
@@ -43,25 +48,30 @@ var _ = func() error {
 
 	func() {
 		b := packr.New("changelogs", "./templates")
-		b.SetResolver("liquibase-changelog/.editorconfig.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "f260e3f66de174069b568ccc5a65c9e6"})
-		b.SetResolver("liquibase-changelog/.gitattributes.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "24a524a03b2f83ca2618ca2cf3c21568"})
-		b.SetResolver("liquibase-changelog/.gitignore.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "b86601b5fb1639b8db9e47d1110c69da"})
-		b.SetResolver("liquibase-changelog/LICENSE.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "aa17e84677fe28cd94276f94d2527f70"})
-		b.SetResolver("liquibase-changelog/README.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "9a4861b8d7e257f1a7cdb7e282e0c596"})
-		b.SetResolver("liquibase-changelog/pom.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "3f9304931980ffe765d5d90b67dade73"})
-		b.SetResolver("liquibase-changelog/src/main/java/io/github/photowey/liquibase/changelog/App.java.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1e52d4bcf26c573f0f83bfa45c8e7242"})
-		b.SetResolver("liquibase-changelog/src/main/resources/application.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "e2c22af38a454e78330ee23ebd8c080e"})
-		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/global/liquibase.global.database.types.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "cbc332645c189e3a7ea98e4827de74f0"})
-		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/mysql/changelogs/v1.0.0/example_employee_1.0.0.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "21607e2509eb56fd4f9f3c474dd36ab4"})
-		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/mysql/changelogs/v1.0.0/template_employee_1.0.0.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1d190baa7313f69cfb1fb316b52b8244"})
-		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/mysql/master.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "5d7fb7a955b33ac492a013edd9d1e702"})
-		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/postgres/changelogs/v1.0.0/.Keep.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "17432597fb18cbcdf1095b70c36fba9a"})
-		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/postgres/master.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "f14d4ca455d8d506814c60ed3c54c665"})
-		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/sqlite/changelogs/v1.0.0/.Keep.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "9b3a0146c042e41f66bcae83ac7f535c"})
-		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/sqlite/master.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "ef1db579d12d4eaafd2c204fa0f530da"})
-		b.SetResolver("liquibase-changelog/src/main/resources/static/.Keep.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "d20aa1521e174c0941694baf26a11c37"})
-		b.SetResolver("liquibase-changelog/src/main/resources/templates/.Keep.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "8e9bbddd194b966d47bed51495272b07"})
-		b.SetResolver("liquibase-changelog/src/test/java/io/github/photowey/liquibase/changelog/AppTests.java.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "d62b76745af14169998a81b260a1387a"})
+		b.SetResolver("builds/gradle/build.gradle.kts.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1e377ae0ad7454dc9c4c42aa8998d40b"})
+		b.SetResolver("builds/gradle/liquibase.properties.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "c431d1fa3be6abcaa94f1c532ea4382f"})
+		b.SetResolver("builds/gradle/settings.gradle.kts.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "d85e378becb2fc1ffe9bca2b9232169b"})
+		b.SetResolver("builds/maven/pom.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "391243b40806a91edade2b3bb340370c"})
+		b.SetResolver("changelogs/table.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1fa80dc3a6324f053e7ab61d22dcb908"})
+		b.SetResolver("liquibase-changelog/.editorconfig.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "31892fcf485f3caa6a4a8eb3660bc8e5"})
+		b.SetResolver("liquibase-changelog/.gitattributes.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "edb0a9ca7fdad246e78a1c382185ef6a"})
+		b.SetResolver("liquibase-changelog/.gitignore.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "a15dd157ca9616932f4a8f73b77ffc95"})
+		b.SetResolver("liquibase-changelog/LICENSE.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1ccd0ac777924bb29f67e89c0f400ec0"})
+		b.SetResolver("liquibase-changelog/README.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "7f39f553fe51e94e5881fafd200b2656"})
+		b.SetResolver("liquibase-changelog/src/main/java/App.java.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "2c88c8cb98ff3809d8a828551a6057d6"})
+		b.SetResolver("liquibase-changelog/src/main/resources/application-dev.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "3d6f7271175a241c6676a0e0b6784c33"})
+		b.SetResolver("liquibase-changelog/src/main/resources/application-prod.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "81e8eb5ed888c2786153702c19918a38"})
+		b.SetResolver("liquibase-changelog/src/main/resources/application-test.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "44547f82f1c14c3f4dc1220222361dc9"})
+		b.SetResolver("liquibase-changelog/src/main/resources/application.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "b583be067fe923089841f9549fc9401e"})
+		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/global/liquibase.global.database.types.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "7432ab9e5a25c364f38dd8b1f60f0313"})
+		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/master.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "b7203b786f1daff425d1b72acb1eff57"})
+		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/mysql/changelogs/v1.0.0/example_employee_1.0.0.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "19f0f1425f9f946c895558eb80d01d1a"})
+		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/postgres/changelogs/v1.0.0/.Keep.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "7e56aa830a76658249dcb8d51af22e93"})
+		b.SetResolver("liquibase-changelog/src/main/resources/liquibase/sqlite/changelogs/v1.0.0/.Keep.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "67950aab6673648c69c9e937ba6f763f"})
+		b.SetResolver("liquibase-changelog/src/main/resources/static/.Keep.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "3f45035f9e4e08d07dea06db53882aad"})
+		b.SetResolver("liquibase-changelog/src/main/resources/templates/.Keep.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "5bf858642f8b603a2981417774af8e20"})
+		b.SetResolver("liquibase-changelog/src/test/java/AppTests.java.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1dd8b89f38069e42fb7bf0b92c84b1ff"})
+		b.SetResolver("manifest.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "22bea56d399aca323625c5f2146ab078"})
 	}()
 	return nil
 }()
@@ -18,13 +18,12 @@ package changelog
 
 import (
 	"bytes"
-	"text/template"
 )
 
 // ----------------------------------------------------------------
 
 func parseField(column *Column, tmpl string) ([]byte, error) {
-	tmp, err := template.New("").Parse(tmpl)
+	tmp, err := newTemplate(tmpl)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +37,35 @@ func parseField(column *Column, tmpl string) ([]byte, error) {
 }
 
 func parseTmpl(ctx *Context, tmpl string) ([]byte, error) {
-	tmp, err := template.New("").Parse(tmpl)
+	tmp, err := newTemplate(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+
+	if err = tmp.Execute(&buf, ctx); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func parseDiff(ctx *DiffContext, tmpl string) ([]byte, error) {
+	tmp, err := newTemplate(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+
+	if err = tmp.Execute(&buf, ctx); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func parseChange(ctx *ChangeContext, tmpl string) ([]byte, error) {
+	tmp, err := newTemplate(tmpl)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,137 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/photowey/liquigen/internal/cmd/database/diff"
+)
+
+// ----------------------------------------------------------------
+
+// OnFail what Liquibase does with a changeSet whose preconditions fail.
+type OnFail string
+
+const (
+	OnFailHalt     OnFail = "HALT"
+	OnFailContinue OnFail = "CONTINUE"
+	OnFailMarkRan  OnFail = "MARK_RAN"
+	OnFailWarn     OnFail = "WARN"
+)
+
+const (
+	TableExists  = "tableExists"
+	ColumnExists = "columnExists"
+	IndexExists  = "indexExists"
+)
+
+var _onFails = []OnFail{OnFailHalt, OnFailContinue, OnFailMarkRan, OnFailWarn}
+
+// ----------------------------------------------------------------
+
+// ParseOnFail parses the onFail of the preconditions, MARK_RAN by default.
+func ParseOnFail(name string) (OnFail, error) {
+	if name == EmptyString {
+		return OnFailMarkRan, nil
+	}
+
+	for _, onFail := range _onFails {
+		if strings.EqualFold(string(onFail), name) {
+			return onFail, nil
+		}
+	}
+
+	return OnFailMarkRan, fmt.Errorf("unknown precondition onFail %q, want HALT, CONTINUE, MARK_RAN or WARN", name)
+}
+
+// ----------------------------------------------------------------
+
+// Precondition one check of the preconditions of a changeSet, e.g. <not><tableExists/></not>.
+type Precondition struct {
+	// Check the Liquibase precondition: tableExists, columnExists or indexExists.
+	Check  string
+	Not    bool
+	Table  string
+	Column string
+	Index  string
+}
+
+// tablePreconditions the checks of the changeSet creating table: the table does not exist.
+func tablePreconditions(table string) []*Precondition {
+	return []*Precondition{{Check: TableExists, Not: true, Table: table}}
+}
+
+// changePreconditions the checks matched to the kind of change, so that a changeSet
+// already applied to a legacy schema is detected before it fails.
+func changePreconditions(change *diff.Change) []*Precondition {
+	table := change.Table.Name
+
+	switch change.Kind {
+	case diff.CreateTable:
+		return tablePreconditions(table)
+	case diff.DropTable, diff.SetTableRemarks:
+		return []*Precondition{{Check: TableExists, Table: table}}
+	case diff.RenameTable:
+		return []*Precondition{
+			{Check: TableExists, Table: change.FromTable.Name},
+			{Check: TableExists, Not: true, Table: table},
+		}
+	case diff.AddColumn:
+		return []*Precondition{{Check: ColumnExists, Not: true, Table: table, Column: change.Column.Name}}
+	case diff.RenameColumn:
+		return []*Precondition{
+			{Check: ColumnExists, Table: table, Column: change.From.Name},
+			{Check: ColumnExists, Not: true, Table: table, Column: change.Column.Name},
+		}
+	case diff.CreateIndex:
+		return []*Precondition{{Check: IndexExists, Not: true, Table: table, Index: change.Index.Name}}
+	case diff.DropIndex:
+		return []*Precondition{{Check: IndexExists, Table: table, Index: change.Index.Name}}
+	default:
+		// the column modifications: dropColumn, modifyDataType, the not null constraints,
+		// the default values and the remarks.
+		return []*Precondition{{Check: ColumnExists, Table: table, Column: change.Column.Name}}
+	}
+}
+
+// renderPreconditions the <preConditions> element of the checks, indented for a changeSet.
+func renderPreconditions(onFail OnFail, checks []*Precondition) string {
+	var builder strings.Builder
+
+	builder.WriteString(fmt.Sprintf(`<preConditions onFail="%s">`, onFail))
+	for _, check := range checks {
+		element := fmt.Sprintf(`<%s tableName="%s"`, check.Check, escapeXML(check.Table))
+		if check.Column != EmptyString {
+			element += fmt.Sprintf(` columnName="%s"`, escapeXML(check.Column))
+		}
+		if check.Index != EmptyString {
+			element += fmt.Sprintf(` indexName="%s"`, escapeXML(check.Index))
+		}
+		element += "/>"
+
+		if check.Not {
+			builder.WriteString("\n            <not>\n                " + element + "\n            </not>")
+		} else {
+			builder.WriteString("\n            " + element)
+		}
+	}
+	builder.WriteString("\n        </preConditions>")
+
+	return builder.String()
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/internal/cmd/database/diff"
+)
+
+func TestChangePreconditions(t *testing.T) {
+	employee := &ast.Table{Name: "employee"}
+	column := &ast.Column{Name: "org_name"}
+	index := &ast.Index{Name: "idx_org_id"}
+
+	tests := []struct {
+		name   string
+		change *diff.Change
+		want   string
+	}{
+		{name: "create table", change: &diff.Change{Kind: diff.CreateTable, Table: employee},
+			want: `<not><tableExists tableName="employee"/></not>`},
+		{name: "drop table", change: &diff.Change{Kind: diff.DropTable, Table: employee},
+			want: `<tableExists tableName="employee"/>`},
+		{name: "rename table", change: &diff.Change{Kind: diff.RenameTable, Table: employee, FromTable: &ast.Table{Name: "staff"}},
+			want: `<tableExists tableName="staff"/><not><tableExists tableName="employee"/></not>`},
+		{name: "add column", change: &diff.Change{Kind: diff.AddColumn, Table: employee, Column: column},
+			want: `<not><columnExists tableName="employee" columnName="org_name"/></not>`},
+		{name: "drop column", change: &diff.Change{Kind: diff.DropColumn, Table: employee, Column: column},
+			want: `<columnExists tableName="employee" columnName="org_name"/>`},
+		{name: "modify data type", change: &diff.Change{Kind: diff.ModifyDataType, Table: employee, Column: column, From: column},
+			want: `<columnExists tableName="employee" columnName="org_name"/>`},
+		{name: "rename column", change: &diff.Change{Kind: diff.RenameColumn, Table: employee, Column: &ast.Column{Name: "organization_name"}, From: column},
+			want: `<columnExists tableName="employee" columnName="org_name"/><not><columnExists tableName="employee" columnName="organization_name"/></not>`},
+		{name: "create index", change: &diff.Change{Kind: diff.CreateIndex, Table: employee, Index: index},
+			want: `<not><indexExists tableName="employee" indexName="idx_org_id"/></not>`},
+		{name: "drop index", change: &diff.Change{Kind: diff.DropIndex, Table: employee, Index: index},
+			want: `<indexExists tableName="employee" indexName="idx_org_id"/>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered := renderPreconditions(OnFailMarkRan, changePreconditions(tt.change))
+
+			var got strings.Builder
+			for _, line := range strings.Split(rendered, "\n") {
+				got.WriteString(strings.TrimSpace(line))
+			}
+
+			want := `<preConditions onFail="MARK_RAN">` + tt.want + `</preConditions>`
+			if got.String() != want {
+				t.Errorf("changePreconditions() got = %s, want %s", got.String(), want)
+			}
+		})
+	}
+}
+
+func TestChangeSetPolicy_preconditions(t *testing.T) {
+	policy := DefaultChangeSetPolicy()
+	if got := policy.preconditions(tablePreconditions("employee")); got != EmptyString {
+		t.Errorf("preconditions() got = %q, want none by default", got)
+	}
+
+	policy.Preconditions = true
+	policy.OnFail = OnFailHalt
+	if got := policy.preconditions(tablePreconditions("employee")); !strings.HasPrefix(got, `<preConditions onFail="HALT">`) {
+		t.Errorf("preconditions() got = %q, want the HALT preconditions", got)
+	}
+}
+
+func TestParseOnFail(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    OnFail
+		wantErr bool
+	}{
+		{name: "", want: OnFailMarkRan},
+		{name: "mark_ran", want: OnFailMarkRan},
+		{name: "HALT", want: OnFailHalt},
+		{name: "warn", want: OnFailWarn},
+		{name: "ignore", want: OnFailMarkRan, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOnFail(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseOnFail() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseOnFail() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
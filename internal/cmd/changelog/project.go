@@ -0,0 +1,239 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/photowey/liquigen/configs"
+	"github.com/photowey/liquigen/pkg/stringz"
+)
+
+// ----------------------------------------------------------------
+
+// Build the build tool of the scaffold.
+type Build string
+
+const (
+	BuildMaven  Build = "maven"
+	BuildGradle Build = "gradle"
+)
+
+var _builds = []Build{BuildMaven, BuildGradle}
+
+// ParseBuild parses the name of a build tool, maven by default.
+func ParseBuild(name string) (Build, error) {
+	if name == EmptyString {
+		return BuildMaven, nil
+	}
+
+	for _, build := range _builds {
+		if strings.EqualFold(string(build), name) {
+			return build, nil
+		}
+	}
+
+	return BuildMaven, fmt.Errorf("unknown build %q, want maven or gradle", name)
+}
+
+// ----------------------------------------------------------------
+
+const (
+	DefaultGroupId           = "io.github.photowey"
+	DefaultArtifactId        = "liquibase-changelog"
+	DefaultArtifactVersion   = "1.0.0-SNAPSHOT"
+	DefaultPackage           = "io.github.photowey.liquibase.changelog"
+	DefaultServerPort        = 9527
+	DefaultJavaVersion       = "1.8"
+	DefaultSpringBootVersion = "2.7.5"
+	DefaultLiquibaseVersion  = "4.9.1"
+)
+
+var (
+	_packageRegexp = regexp.MustCompile(`^[a-zA-Z_]\w*(\.[a-zA-Z_]\w*)*$`)
+
+	// _drivers the JDBC driver of every dialect, managed by the Spring Boot parent.
+	_drivers = map[string]string{
+		"mysql":    "mysql:mysql-connector-java",
+		"postgres": "org.postgresql:postgresql",
+		"sqlite":   "org.xerial:sqlite-jdbc",
+	}
+)
+
+// ----------------------------------------------------------------
+
+// Project the coordinates and the versions of the generated scaffold.
+type Project struct {
+	Build Build
+
+	GroupId         string
+	ArtifactId      string
+	ArtifactVersion string
+	// Package the Java package of the application, e.g. io.github.photowey.liquibase.changelog.
+	Package    string
+	ServerPort int
+
+	JavaVersion       string
+	SpringBootVersion string
+	LiquibaseVersion  string
+
+	// Drivers the JDBC driver of every dialect, as groupId:artifactId[:version].
+	Drivers map[string]string
+}
+
+// Driver the Maven coordinates of a JDBC driver, the version managed by the Spring Boot
+// parent when blank.
+type Driver struct {
+	GroupId    string
+	ArtifactId string
+	Version    string
+}
+
+func DefaultProject() *Project {
+	drivers := make(map[string]string, len(_drivers))
+	for dialect, driver := range _drivers {
+		drivers[dialect] = driver
+	}
+
+	return &Project{
+		Build:           BuildMaven,
+		GroupId:         DefaultGroupId,
+		ArtifactId:      DefaultArtifactId,
+		ArtifactVersion: DefaultArtifactVersion,
+		Package:         DefaultPackage,
+		ServerPort:      DefaultServerPort,
+
+		JavaVersion:       DefaultJavaVersion,
+		SpringBootVersion: DefaultSpringBootVersion,
+		LiquibaseVersion:  DefaultLiquibaseVersion,
+
+		Drivers: drivers,
+	}
+}
+
+// ConfigProject the project of the project section of liquigen.json, the blank settings
+// falling back to the default project.
+func ConfigProject() (*Project, error) {
+	conf := configs.ConfigProject()
+	project := DefaultProject()
+
+	build, err := ParseBuild(conf.Build)
+	if err != nil {
+		return nil, err
+	}
+
+	project.Merge(&Project{
+		Build:           build,
+		GroupId:         conf.GroupId,
+		ArtifactId:      conf.ArtifactId,
+		ArtifactVersion: conf.ArtifactVersion,
+		Package:         conf.Package,
+		ServerPort:      conf.ServerPort,
+
+		JavaVersion:       conf.JavaVersion,
+		SpringBootVersion: conf.SpringBootVersion,
+		LiquibaseVersion:  conf.LiquibaseVersion,
+
+		Drivers: conf.Drivers,
+	})
+
+	return project, nil
+}
+
+// Merge overrides the settings of p with the non-blank settings of other.
+func (p *Project) Merge(other *Project) {
+	merge := func(dst *string, src string) {
+		if stringz.IsNotBlankString(src) {
+			*dst = src
+		}
+	}
+
+	if other.Build != EmptyString {
+		p.Build = other.Build
+	}
+
+	merge(&p.GroupId, other.GroupId)
+	merge(&p.ArtifactId, other.ArtifactId)
+	merge(&p.ArtifactVersion, other.ArtifactVersion)
+	merge(&p.Package, other.Package)
+	merge(&p.JavaVersion, other.JavaVersion)
+	merge(&p.SpringBootVersion, other.SpringBootVersion)
+	merge(&p.LiquibaseVersion, other.LiquibaseVersion)
+
+	if other.ServerPort > 0 {
+		p.ServerPort = other.ServerPort
+	}
+
+	for dialect, driver := range other.Drivers {
+		if stringz.IsNotBlankString(driver) {
+			p.Drivers[strings.ToLower(dialect)] = driver
+		}
+	}
+}
+
+// Validate checks the Java package and the JDBC drivers.
+func (p *Project) Validate() error {
+	if !_packageRegexp.MatchString(p.Package) {
+		return fmt.Errorf("project: invalid Java package %q", p.Package)
+	}
+
+	for dialect := range p.Drivers {
+		if _, err := p.Driver(dialect); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PackageDir the directory of the Java package, e.g. io/github/photowey/liquibase/changelog.
+func (p *Project) PackageDir() string {
+	return strings.ReplaceAll(p.Package, ".", "/")
+}
+
+// Driver the JDBC driver of dialect, nil when unknown.
+func (p *Project) Driver(dialect string) (*Driver, error) {
+	coordinates, ok := p.Drivers[strings.ToLower(dialect)]
+	if !ok {
+		return nil, nil
+	}
+
+	parts := strings.Split(coordinates, ":")
+	if len(parts) < 2 || len(parts) > 3 || stringz.IsBlankString(parts[0]) || stringz.IsBlankString(parts[1]) {
+		return nil, fmt.Errorf("project: invalid %s JDBC driver %q, want groupId:artifactId[:version]", dialect, coordinates)
+	}
+
+	driver := &Driver{GroupId: parts[0], ArtifactId: parts[1]}
+	if len(parts) == 3 {
+		driver.Version = parts[2]
+	}
+
+	return driver, nil
+}
+
+// ----------------------------------------------------------------
+
+// projectOf the project of args, the default one when unset.
+func projectOf(args *Args) *Project {
+	if args.Project == nil {
+		return DefaultProject()
+	}
+
+	return args.Project
+}
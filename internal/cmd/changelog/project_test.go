@@ -0,0 +1,123 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProject_Merge(t *testing.T) {
+	project := DefaultProject()
+	project.Merge(&Project{
+		GroupId:    "com.acme",
+		Package:    "com.acme.billing",
+		ServerPort: 8080,
+		Drivers:    map[string]string{"MySQL": "com.mysql:mysql-connector-j", "postgres": ""},
+	})
+
+	if project.GroupId != "com.acme" || project.ArtifactId != DefaultArtifactId {
+		t.Errorf("Merge() got coordinates = %s:%s", project.GroupId, project.ArtifactId)
+	}
+	if project.Package != "com.acme.billing" || project.ServerPort != 8080 {
+		t.Errorf("Merge() got package = %s, port = %d", project.Package, project.ServerPort)
+	}
+	if got := project.Drivers["mysql"]; got != "com.mysql:mysql-connector-j" {
+		t.Errorf("Merge() got mysql driver = %s", got)
+	}
+	if got := project.Drivers["postgres"]; got != _drivers["postgres"] {
+		t.Errorf("Merge() got postgres driver = %s, want %s", got, _drivers["postgres"])
+	}
+	if DefaultProject().Drivers["mysql"] != _drivers["mysql"] {
+		t.Errorf("Merge() changed the default drivers")
+	}
+}
+
+func TestProject_Driver(t *testing.T) {
+	project := &Project{Drivers: map[string]string{
+		"mysql":    "com.mysql:mysql-connector-j:8.0.33",
+		"postgres": "org.postgresql:postgresql",
+		"sqlite":   "sqlite-jdbc",
+	}}
+
+	tests := []struct {
+		dialect string
+		want    *Driver
+		wantErr bool
+	}{
+		{dialect: "mysql", want: &Driver{GroupId: "com.mysql", ArtifactId: "mysql-connector-j", Version: "8.0.33"}},
+		{dialect: "Postgres", want: &Driver{GroupId: "org.postgresql", ArtifactId: "postgresql"}},
+		{dialect: "oracle"},
+		{dialect: "sqlite", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run("test "+tt.dialect, func(t *testing.T) {
+			got, err := project.Driver(tt.dialect)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Driver() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Driver() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProject_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		pkg     string
+		wantErr bool
+	}{
+		{name: "test default package", pkg: DefaultPackage},
+		{name: "test single package", pkg: "app"},
+		{name: "test trailing dot", pkg: "com.acme.", wantErr: true},
+		{name: "test dash", pkg: "com.acme-billing", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := DefaultProject()
+			project.Package = tt.pkg
+			if err := project.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseBuild(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Build
+		wantErr bool
+	}{
+		{name: "", want: BuildMaven},
+		{name: "Gradle", want: BuildGradle},
+		{name: "ant", want: BuildMaven, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run("test "+tt.name, func(t *testing.T) {
+			got, err := ParseBuild(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseBuild() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseBuild() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
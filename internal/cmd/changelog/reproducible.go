@@ -0,0 +1,94 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/pkg/stringz"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	// SourceDateEpoch the environment variable of the reproducible builds, the generation
+	// date in seconds since the Unix epoch.
+	SourceDateEpoch = "SOURCE_DATE_EPOCH"
+
+	DateLayout = "2006-01-02"
+)
+
+var _dateLayouts = []string{DateLayout, DatetimeLayout, time.RFC3339}
+
+// ----------------------------------------------------------------
+
+// ResolveDate the generation date stamped into the changeSet ids and the changelog paths:
+// value(yyyy-MM-dd, yyyyMMdd or RFC 3339), then SOURCE_DATE_EPOCH, then the current time.
+func ResolveDate(value string) (time.Time, error) {
+	if stringz.IsNotBlankString(value) {
+		for _, layout := range _dateLayouts {
+			if date, err := time.Parse(layout, value); err == nil {
+				return date, nil
+			}
+		}
+
+		return time.Time{}, fmt.Errorf("invalid date %q, want yyyy-MM-dd, yyyyMMdd or RFC 3339", value)
+	}
+
+	if epoch := strings.TrimSpace(os.Getenv(SourceDateEpoch)); epoch != EmptyString {
+		seconds, err := strconv.ParseInt(epoch, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid %s %q, want the seconds since the Unix epoch", SourceDateEpoch, epoch)
+		}
+
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+
+	return time.Now(), nil
+}
+
+// generationDate the generation date of args, the current time when unset.
+func generationDate(args *Args) time.Time {
+	if args.Date.IsZero() {
+		return time.Now()
+	}
+
+	return args.Date
+}
+
+// ----------------------------------------------------------------
+
+// sortIndexes orders the indexes of every table by name, whatever order the SQL file, the
+// changelog or the database reported them in; the tables and the columns keep the order
+// of the schema.
+func sortIndexes(database *ast.Database) {
+	if database == nil {
+		return
+	}
+
+	for _, table := range database.Tables {
+		sort.SliceStable(table.Indexes, func(i, j int) bool {
+			return table.Indexes[i].Name < table.Indexes[j].Name
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+)
+
+func TestResolveDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		epoch   string
+		want    string
+		wantErr bool
+	}{
+		{name: "date", value: "2024-10-27", epoch: "0", want: "20241027"},
+		{name: "compact date", value: "20241027", want: "20241027"},
+		{name: "RFC 3339", value: "2024-10-27T23:30:00+08:00", want: "20241027"},
+		{name: "invalid date", value: "27/10/2024", wantErr: true},
+		{name: "SOURCE_DATE_EPOCH", epoch: "1729987200", want: "20241027"},
+		{name: "invalid SOURCE_DATE_EPOCH", epoch: "yesterday", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(SourceDateEpoch, tt.epoch)
+
+			got, err := ResolveDate(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Format(DatetimeLayout) != tt.want {
+				t.Errorf("ResolveDate() got = %s, want %s", got.Format(DatetimeLayout), tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveDate_now(t *testing.T) {
+	t.Setenv(SourceDateEpoch, EmptyString)
+
+	got, err := ResolveDate(EmptyString)
+	if err != nil {
+		t.Fatalf("ResolveDate() error = %v", err)
+	}
+	if time.Since(got) > time.Minute {
+		t.Errorf("ResolveDate() got = %v, want the current time", got)
+	}
+}
+
+func TestInitCtx_date(t *testing.T) {
+	args := &Args{Author: "dev", Version: "1.0.0", Dialect: "mysql", Path: "/repo",
+		Date: time.Date(2024, 10, 27, 0, 0, 0, 0, time.UTC)}
+	table := &ast.Table{Name: "employee"}
+
+	first, second := initCtx(args, table), initCtx(args, table)
+	if first.ChangeSet.ID != "employee_20241027_001" || !reflect.DeepEqual(first, second) {
+		t.Errorf("initCtx() got = %+v and %+v, want the changeSet employee_20241027_001 twice", first.ChangeSet, second.ChangeSet)
+	}
+}
+
+func TestSortIndexes(t *testing.T) {
+	database := &ast.Database{Tables: []*ast.Table{
+		{Name: "employee", Indexes: []*ast.Index{
+			{Name: "uk_employee_no", Columns: []string{"employee_no"}},
+			{Name: "idx_org_id", Columns: []string{"org_id", "dept_id"}},
+		}},
+		{Name: "department"},
+	}}
+
+	sortIndexes(database)
+
+	var got []string
+	for _, index := range database.Tables[0].Indexes {
+		got = append(got, index.Name)
+	}
+	if want := []string{"idx_org_id", "uk_employee_no"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sortIndexes() got = %v, want %v", got, want)
+	}
+	if got := database.Tables[0].Indexes[0].Columns; !reflect.DeepEqual(got, []string{"org_id", "dept_id"}) {
+		t.Errorf("sortIndexes() columns got = %v, want the order of the index", got)
+	}
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ----------------------------------------------------------------
+
+// Scaffold what the changelog generation writes besides the changelogs of the tables.
+type Scaffold string
+
+const (
+	// ScaffoldFull the whole liquibase-changelog project: pom.xml, App.java, master files, ...
+	ScaffoldFull Scaffold = "full"
+	// ScaffoldChangelogs the changelogs only, included by the master changelog of the dialect,
+	// for the existing projects.
+	ScaffoldChangelogs Scaffold = "changelogs"
+
+	// MasterTemplate the master changelog created by the changelogs scaffold when missing,
+	// including first the global types changelog written next to it.
+	MasterTemplate = `<?xml version="1.0" encoding="UTF-8"?>
+<databaseChangeLog
+        xmlns="http://www.liquibase.org/xml/ns/dbchangelog"
+        xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+        xsi:schemaLocation="http://www.liquibase.org/xml/ns/dbchangelog
+        http://www.liquibase.org/xml/ns/dbchangelog/dbchangelog-4.9.xsd">
+
+    <include file="` + GlobalTypesFile + `" relativeToChangelogFile="true"/>
+</databaseChangeLog>
+`
+	// GlobalTypesTemplate the changelog of the ${type.*} properties the changelogs use.
+	GlobalTypesTemplate = ProjectTemplateDir + "/src/main/resources/liquibase/global/liquibase.global.database.types.xml.tmpl"
+	// GlobalTypesFile the global types changelog the changelogs scaffold writes next to the
+	// master changelog it creates.
+	GlobalTypesFile = "liquibase.global.database.types.xml"
+	masterEndTag    = "</databaseChangeLog>"
+)
+
+var (
+	_scaffolds = []Scaffold{ScaffoldFull, ScaffoldChangelogs}
+
+	_includeRegexp    = regexp.MustCompile(`<include\s[^>]*\bfile="([^"]+)"`)
+	_includeAllRegexp = regexp.MustCompile(`<includeAll\s[^>]*\bpath="([^"]+)"`)
+)
+
+// ParseScaffold parses the name of a scaffold, full by default.
+func ParseScaffold(name string) (Scaffold, error) {
+	if name == EmptyString {
+		return ScaffoldFull, nil
+	}
+
+	for _, scaffold := range _scaffolds {
+		if strings.EqualFold(string(scaffold), name) {
+			return scaffold, nil
+		}
+	}
+
+	return ScaffoldFull, fmt.Errorf("unknown scaffold %q, want full or changelogs", name)
+}
+
+// ----------------------------------------------------------------
+
+// testIsChangelogsScaffold reports whether only the changelogs, and the master changelog
+// including them, are generated.
+func testIsChangelogsScaffold(args *Args) bool {
+	return args.Scaffold == ScaffoldChangelogs
+}
+
+// updateMaster plans the update of the master changelog, adding an include of every
+// changelog of output it doesn't include yet, directly or through an includeAll.
+//
+// The includes are relative to the master changelog which, when missing, is created along
+// with the global types changelog defining the ${type.*} properties of the changelogs.
+func updateMaster(args *Args, templates *Templates, output *Output) error {
+	path := outputLayout(args).MasterFile(args)
+
+	existing, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		types, err := templates.FindString(GlobalTypesTemplate)
+		if err != nil {
+			return fmt.Errorf("master: find the template %s failed: %v", GlobalTypesTemplate, err)
+		}
+
+		output.Add(filepath.Join(filepath.Dir(path), GlobalTypesFile), []byte(types), 0o644)
+		existing = []byte(MasterTemplate)
+	} else if err != nil {
+		return err
+	}
+
+	content, err := includeChangelogs(string(existing), filepath.Dir(path), output.Files())
+	if err != nil {
+		return fmt.Errorf("master: %s %v", path, err)
+	}
+
+	output.AddUpdate(path, []byte(content), 0o644)
+
+	return nil
+}
+
+func includeChangelogs(master, dir string, files []*OutputFile) (string, error) {
+	end := strings.LastIndex(master, masterEndTag)
+	if end < 0 {
+		return EmptyString, fmt.Errorf("is not a databaseChangeLog, missing %s", masterEndTag)
+	}
+
+	var includes []string
+	for _, file := range files {
+		if !file.Changelog {
+			continue
+		}
+
+		rel, err := filepath.Rel(dir, file.Path)
+		if err != nil {
+			return EmptyString, err
+		}
+
+		rel = filepath.ToSlash(rel)
+		if testIsIncluded(master, rel) {
+			continue
+		}
+
+		includes = append(includes, fmt.Sprintf(`    <include file="%s" relativeToChangelogFile="true"/>`, rel))
+	}
+
+	if len(includes) == 0 {
+		return master, nil
+	}
+
+	return master[:end] + strings.Join(includes, "\n") + "\n" + master[end:], nil
+}
+
+func testIsIncluded(master, rel string) bool {
+	for _, match := range _includeRegexp.FindAllStringSubmatch(master, -1) {
+		if match[1] == rel {
+			return true
+		}
+	}
+	for _, match := range _includeAllRegexp.FindAllStringSubmatch(master, -1) {
+		if strings.HasPrefix(rel, strings.TrimSuffix(match[1], "/")+"/") {
+			return true
+		}
+	}
+
+	return false
+}
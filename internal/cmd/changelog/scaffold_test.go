@@ -0,0 +1,143 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseScaffold(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Scaffold
+		wantErr bool
+	}{
+		{name: "", want: ScaffoldFull},
+		{name: "full", want: ScaffoldFull},
+		{name: "Changelogs", want: ScaffoldChangelogs},
+		{name: "gradle", want: ScaffoldFull, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run("test "+tt.name, func(t *testing.T) {
+			got, err := ParseScaffold(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseScaffold() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseScaffold() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIncludeChangelogs(t *testing.T) {
+	dir := filepath.FromSlash("/repo/db")
+	files := []*OutputFile{
+		{Path: filepath.Join(dir, "changelogs", "v1.0.1", "employee.xml"), Changelog: true},
+		{Path: filepath.Join(dir, "1.0.1", "department.xml"), Changelog: true},
+		{Path: filepath.Join(dir, "pom.xml")},
+	}
+
+	tests := []struct {
+		name    string
+		master  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "test new includes",
+			master: "<databaseChangeLog>\n</databaseChangeLog>\n",
+			want: "<databaseChangeLog>\n" +
+				"    <include file=\"changelogs/v1.0.1/employee.xml\" relativeToChangelogFile=\"true\"/>\n" +
+				"    <include file=\"1.0.1/department.xml\" relativeToChangelogFile=\"true\"/>\n" +
+				"</databaseChangeLog>\n",
+		},
+		{
+			name: "test already included",
+			master: "<databaseChangeLog>\n" +
+				"    <includeAll path=\"changelogs/\" relativeToChangelogFile=\"true\"/>\n" +
+				"    <include file=\"1.0.1/department.xml\" relativeToChangelogFile=\"true\"/>\n" +
+				"</databaseChangeLog>\n",
+			want: "<databaseChangeLog>\n" +
+				"    <includeAll path=\"changelogs/\" relativeToChangelogFile=\"true\"/>\n" +
+				"    <include file=\"1.0.1/department.xml\" relativeToChangelogFile=\"true\"/>\n" +
+				"</databaseChangeLog>\n",
+		},
+		{name: "test not a changelog", master: "<project/>", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := includeChangelogs(tt.master, dir, files)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("includeChangelogs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("includeChangelogs() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutput_Update(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "master.xml")
+	if err := os.WriteFile(path, []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	output := NewOutput(&Args{Conflict: ConflictFail})
+	output.AddUpdate(path, []byte("new"), 0o644)
+	if err := output.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	if got := output.Files()[0].Action; got != FileUpdated {
+		t.Errorf("Flush() got action = %v, want %v", got, FileUpdated)
+	}
+	if got, _ := os.ReadFile(path); string(got) != "new" {
+		t.Errorf("Flush() got content = %s, want new", got)
+	}
+}
+
+func TestUpdateMaster_new(t *testing.T) {
+	args := &Args{Path: t.TempDir(), Dialect: "mysql", Version: "1.0.0"}
+	master := outputLayout(args).MasterFile(args)
+
+	output := NewOutput(args)
+	output.AddChangelog(filepath.Join(filepath.Dir(master), "changelogs", "v1.0.0", "employee_1.0.0.xml"), []byte("employee"), 0o644)
+	if err := updateMaster(args, templatesOf(args), output); err != nil {
+		t.Fatalf("updateMaster() error = %v", err)
+	}
+
+	files := output.Files()
+	if len(files) != 3 || files[1].Path != filepath.Join(filepath.Dir(master), GlobalTypesFile) || files[2].Path != master {
+		t.Fatalf("updateMaster() got files = %+v, want the global types and the master changelogs", files)
+	}
+	if !strings.Contains(string(files[1].Content), `<property name="type.varchar"`) {
+		t.Errorf("updateMaster() got types = %s, want the type properties", files[1].Content)
+	}
+
+	want := "    <include file=\"" + GlobalTypesFile + "\" relativeToChangelogFile=\"true\"/>\n" +
+		"    <include file=\"changelogs/v1.0.0/employee_1.0.0.xml\" relativeToChangelogFile=\"true\"/>\n" +
+		"</databaseChangeLog>\n"
+	if got := string(files[2].Content); !strings.HasSuffix(got, want) {
+		t.Errorf("updateMaster() got master = %s, want the types included first", got)
+	}
+}
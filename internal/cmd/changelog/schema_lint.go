@@ -0,0 +1,106 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/photowey/liquigen/configs"
+	"github.com/photowey/liquigen/internal/cmd/database/lint"
+	"github.com/photowey/liquigen/pkg/stringz"
+)
+
+// ----------------------------------------------------------------
+
+// SchemaLintArgs the arguments of the lint-schema command.
+type SchemaLintArgs struct {
+	// Targets the target dialects of the reserved-word and identifier-length rules, over the
+	// lint.dialects of liquigen.json.
+	Targets []string
+	// FailOn the severity failing the lint with a non-zero exit.
+	FailOn lint.Severity
+	// JSON the writer of the JSON report, nil for the colored one.
+	JSON io.Writer
+}
+
+// ----------------------------------------------------------------
+
+// ConfigSchemaRules the schema rules of the lint section of liquigen.json, over the default
+// ones; the target dialects default to dialect.
+func ConfigSchemaRules(dialect string) (*lint.SchemaRules, error) {
+	conf := configs.ConfigLint()
+	rules := lint.DefaultSchemaRules()
+
+	for rule, severity := range conf.Rules {
+		if err := rules.SetSeverity(rule, severity); err != nil {
+			return nil, err
+		}
+	}
+
+	rules.Dialects = conf.Dialects
+	if len(rules.Dialects) == 0 && stringz.IsNotBlankString(dialect) {
+		rules.Dialects = []string{dialect}
+	}
+	if conf.IdentifierLength > 0 {
+		rules.IdentifierLength = conf.IdentifierLength
+	}
+	if conf.VarcharLength > 0 {
+		rules.VarcharLength = conf.VarcharLength
+	}
+	if conf.AuditColumns != nil {
+		rules.AuditColumns = conf.AuditColumns
+	}
+	rules.ReservedWords = conf.ReservedWords
+
+	return rules, nil
+}
+
+// ----------------------------------------------------------------
+
+// OnSchemaLint checks the schema parsed, imported or introspected, as the changelog command
+// does before generating, against the schema rules, and exits non-zero when a problem is at
+// least as severe as lintArgs.FailOn.
+func OnSchemaLint(args *Args, lintArgs *SchemaLintArgs) {
+	load(args)
+
+	rules, err := ConfigSchemaRules(args.Dialect)
+	if err != nil {
+		panic(err)
+	}
+	if len(lintArgs.Targets) > 0 {
+		rules.Dialects = lintArgs.Targets
+	}
+
+	problems, err := lint.Schema(args.Ast.Database, rules)
+	if err != nil {
+		panic(err)
+	}
+
+	if lintArgs.JSON != nil {
+		printLintJSON(lintArgs.JSON, problems)
+	} else {
+		printProblems("Schema", problems)
+		fmt.Println(yellow("Schema: linted ->"), cyan(fmt.Sprintf("%d tables, %d errors, %d warnings",
+			len(args.Ast.Database.Tables), lint.Count(problems, lint.Error), lint.Count(problems, lint.Warning))))
+	}
+
+	if lint.Failed(problems, lintArgs.FailOn) {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/internal/cmd/database/snapshot"
+	"github.com/photowey/liquigen/pkg/filez"
+	"github.com/photowey/liquigen/pkg/stringz"
+)
+
+// ----------------------------------------------------------------
+
+func OnSnapshotMode(args *Args) {
+	loadSnapshot(args)
+	confirm(args)
+
+	gen(args)
+}
+
+// OnSnapshotExport parses the SQL file, imports the Liquibase changelog, or introspects
+// the database, and writes
+// the resulting ast.Database to the snapshot file output.
+func OnSnapshotExport(args *Args, output string) {
+	load(args)
+
+	output, err := filez.Clean(output)
+	if err != nil {
+		panic(err)
+	}
+
+	if err = snapshot.Write(output, snapshot.NewSnapshot(args.Dialect, args.Ast.Database)); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(yellow("Snapshot: exported ->"),
+		cyan(fmt.Sprintf("%s(%d tables)", output, len(args.Ast.Database.Tables))))
+}
+
+// ----------------------------------------------------------------
+
+// load builds args.Ast from whichever input was given: a SQL file, a snapshot file,
+// a Liquibase changelog or, by default, the live database.
+func load(args *Args) {
+	switch {
+	case stringz.IsNotBlankString(args.SQLFile):
+		loadSQL(args)
+	case stringz.IsNotBlankString(args.Snapshot):
+		loadSnapshot(args)
+	case stringz.IsNotBlankString(args.Liquibase):
+		loadLiquibase(args)
+	default:
+		loadDatabase(args)
+	}
+
+	sortIndexes(args.Ast.Database)
+}
+
+func loadSnapshot(args *Args) {
+	snapshotFile, err := filez.Clean(args.Snapshot)
+	if err != nil {
+		panic(err)
+	}
+
+	snap, err := snapshot.Read(snapshotFile)
+	if err != nil {
+		panic(err)
+	}
+
+	if stringz.IsBlankString(args.Dialect) {
+		args.Dialect = snap.Dialect
+	}
+
+	args.Ast = &ast.Ast{
+		Database: snap.Database,
+	}
+}
@@ -19,7 +19,6 @@ package changelog
 import (
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/manifoldco/promptui"
 	"github.com/photowey/liquigen/internal/cmd/database"
@@ -31,6 +30,13 @@ import (
 func OnSQLMode(args *Args) {
 	// report(args)
 
+	loadSQL(args)
+	confirm(args)
+
+	gen(args)
+}
+
+func loadSQL(args *Args) {
 	sql, err := readSQL(args.SQLFile)
 	if err != nil {
 		panic(err)
@@ -39,14 +45,12 @@ func OnSQLMode(args *Args) {
 	args.SQL = sql
 	// reportSQL(args)
 
+	validateDialect(args)
+
 	if sqlParser, ok := parser.Acquire(args.Dialect); ok {
 		parseSQL(sqlParser, args)
-		confirm(args)
-
-		gen(args)
 
 		return
-
 	}
 
 	panic(fmt.Errorf("the dialect %s not found", args.Dialect))
@@ -82,7 +86,7 @@ func confirm(args *Args) {
 }
 
 func confirmInput(args *Args) {
-	now := time.Now()
+	now := generationDate(args)
 	layout := "2006/01/02"
 
 	fmt.Println("")
@@ -117,8 +121,6 @@ func confirmInput(args *Args) {
 }
 
 func validateInput(args *Args) {
-	validatePath(args)
-
 	validateAuthor(args)
 	validateVersion(args)
 
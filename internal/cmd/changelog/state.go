@@ -0,0 +1,135 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/internal/version"
+	"github.com/photowey/liquigen/pkg/jsonz"
+	"github.com/photowey/liquigen/pkg/stringz"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	// StateFile the state file, relative to the generated project.
+	StateFile = ".liquigen/state.json"
+	// StateVersion the current version of the state file layout.
+	StateVersion = 1
+)
+
+// ----------------------------------------------------------------
+
+// State the schemas generated into a project, read back by the next generation to
+// emit only the changeSets of the differences.
+type State struct {
+	Version   int    `json:"version"`
+	Generator string `json:"generator"`
+	// Schemas the generated schemas, by dialect.
+	Schemas map[string]*SchemaState `json:"schemas"`
+}
+
+// SchemaState the schema generated for one dialect.
+type SchemaState struct {
+	// Versions the generated changelog versions, in order.
+	Versions []string      `json:"versions"`
+	Database *ast.Database `json:"database"`
+}
+
+func NewState() *State {
+	return &State{
+		Version: StateVersion,
+		Schemas: make(map[string]*SchemaState),
+	}
+}
+
+// Record records the schema generated for the dialect into the changelogs of version.
+func (s *State) Record(dialect, version string, database *ast.Database) {
+	schema, ok := s.Schemas[dialect]
+	if !ok {
+		schema = &SchemaState{}
+		s.Schemas[dialect] = schema
+	}
+
+	if stringz.ArrayNotContains(schema.Versions, version) {
+		schema.Versions = append(schema.Versions, version)
+	}
+	schema.Database = database
+}
+
+// ----------------------------------------------------------------
+
+func stateFile(args *Args) string {
+	return outputLayout(args).ProjectFile(args, StateFile)
+}
+
+// readState reads the state of the project, an empty state when the project has none.
+func readState(args *Args) (*State, error) {
+	data, err := os.ReadFile(stateFile(args))
+	if errors.Is(err, fs.ErrNotExist) {
+		return NewState(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state := NewState()
+	if err = jsonz.UnmarshalStructE(data, state); err != nil {
+		return nil, fmt.Errorf("read state %s failed: %w", stateFile(args), err)
+	}
+	if state.Version > StateVersion {
+		return nil, fmt.Errorf("state: version %d is newer than the supported version %d, please upgrade liquigen",
+			state.Version, StateVersion)
+	}
+
+	return state, nil
+}
+
+func writeState(args *Args, state *State) error {
+	state.Version = StateVersion
+	state.Generator = "liquigen " + version.Now()
+
+	data, err := jsonz.PrettyE(state)
+	if err != nil {
+		return err
+	}
+
+	path := stateFile(args)
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, []byte(data+"\n"), 0o644)
+}
+
+// targetDatabase the tables of database accepted by the includes and excludes.
+func targetDatabase(database *ast.Database) *ast.Database {
+	target := &ast.Database{Name: database.Name}
+	for _, table := range database.Tables {
+		if testIsTargetTable(table.Name) {
+			target.Tables = append(target.Tables, table)
+		}
+	}
+
+	return target
+}
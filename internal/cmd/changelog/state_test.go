@@ -0,0 +1,90 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+)
+
+func TestState(t *testing.T) {
+	args := &Args{Path: t.TempDir()}
+
+	state, err := readState(args)
+	if err != nil {
+		t.Fatalf("readState() error = %v", err)
+	}
+	if len(state.Schemas) != 0 {
+		t.Errorf("readState() got schemas = %v, want empty", state.Schemas)
+	}
+
+	database := &ast.Database{
+		Name:   "company",
+		Tables: []*ast.Table{{Name: "employee", Columns: []*ast.Column{{Name: "id", DataType: "bigint"}}}},
+	}
+	state.Record("mysql", "1.0.0", database)
+	state.Record("mysql", "1.0.1", database)
+	state.Record("mysql", "1.0.1", database)
+
+	if err = writeState(args, state); err != nil {
+		t.Fatalf("writeState() error = %v", err)
+	}
+
+	got, err := readState(args)
+	if err != nil {
+		t.Fatalf("readState() error = %v", err)
+	}
+
+	schema := got.Schemas["mysql"]
+	if want := []string{"1.0.0", "1.0.1"}; !reflect.DeepEqual(schema.Versions, want) {
+		t.Errorf("readState() got versions = %v, want %v", schema.Versions, want)
+	}
+	if !reflect.DeepEqual(schema.Database, database) {
+		t.Errorf("readState() got database = %v, want %v", schema.Database, database)
+	}
+}
+
+func TestTableChangelog_indexes(t *testing.T) {
+	args := &Args{Author: "dev", Version: "1.0.0", Dialect: "mysql", Path: t.TempDir(), Date: time.Date(2024, 10, 27, 0, 0, 0, 0, time.UTC)}
+	table := &ast.Table{Name: "employee",
+		Columns: []*ast.Column{{Name: "id", DataType: "bigint", PrimaryKey: true}, {Name: "employee_no", DataType: "varchar"}},
+		Indexes: []*ast.Index{{Name: "uk_employee_no", Unique: true, Columns: []string{"employee_no"}}},
+	}
+
+	templates := templatesOf(args)
+	manifest, err := readManifest(templates)
+	if err != nil {
+		t.Fatalf("readManifest() error = %v", err)
+	}
+
+	output := NewOutput(args)
+	if err = write(initCtx(args, table), args, templates, manifest, output); err != nil {
+		t.Fatalf("write() error = %v", err)
+	}
+
+	// The state records the indexes, the changelog of the table must create them.
+	want := `<createIndex tableName="employee" indexName="uk_employee_no" unique="true">
+            <column name="employee_no"/>
+        </createIndex>`
+	if files := output.Files(); len(files) != 1 || !strings.Contains(string(files[0].Content), want) {
+		t.Errorf("write() got = %+v, want the changelog creating %s", files, want)
+	}
+}
@@ -0,0 +1,201 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gobuffalo/packr/v2"
+	"github.com/photowey/liquigen/internal/home"
+	"github.com/photowey/liquigen/pkg/filez"
+	"github.com/photowey/liquigen/pkg/stringz"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	templatePackr2    = "changelogs"
+	templatePackr2Dir = "./templates"
+
+	// TemplatesHome the directory of the template sets, in the liquigen home.
+	TemplatesHome = "templates"
+	// DefaultTemplateSet the template set overriding the embedded templates when present.
+	DefaultTemplateSet = "default"
+)
+
+// ----------------------------------------------------------------
+
+// Templates the template files, e.g. liquibase-changelog/README.md.tmpl: the files of the
+// override directory first, then the embedded ones, file by file.
+type Templates struct {
+	dir string
+	box *packr.Box
+}
+
+// NewTemplates the templates overridden by the files of dir, the embedded ones only when
+// dir is blank.
+//
+//go:generate packr2
+func NewTemplates(dir string) *Templates {
+	return &Templates{
+		dir: dir,
+		box: packr.New(templatePackr2, templatePackr2Dir),
+	}
+}
+
+// List the names of the embedded and the overriding templates, sorted.
+func (t *Templates) List() ([]string, error) {
+	names := make(map[string]bool)
+	for _, name := range t.box.List() {
+		names[filepath.ToSlash(name)] = true
+	}
+
+	if stringz.IsNotBlankString(t.dir) {
+		err := filepath.WalkDir(t.dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() || !strings.HasSuffix(path, TmplSuffix) {
+				return err
+			}
+
+			rel, err := filepath.Rel(t.dir, path)
+			if err != nil {
+				return err
+			}
+			names[filepath.ToSlash(rel)] = true
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("templates: list %s failed: %v", t.dir, err)
+		}
+	}
+
+	list := make([]string, 0, len(names))
+	for name := range names {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+
+	return list, nil
+}
+
+// FindString the content of the template name, overridden or embedded.
+func (t *Templates) FindString(name string) (string, error) {
+	if content, ok, err := t.findOverride(name); ok || err != nil {
+		return content, err
+	}
+
+	return t.box.FindString(name)
+}
+
+// Embedded the names of the embedded templates, sorted.
+func (t *Templates) Embedded() []string {
+	var list []string
+	for _, name := range t.box.List() {
+		list = append(list, filepath.ToSlash(name))
+	}
+	sort.Strings(list)
+
+	return list
+}
+
+// EmbeddedString the content of the embedded template name.
+func (t *Templates) EmbeddedString(name string) (string, error) {
+	return t.box.FindString(name)
+}
+
+func (t *Templates) findOverride(name string) (string, bool, error) {
+	if stringz.IsBlankString(t.dir) {
+		return EmptyString, false, nil
+	}
+
+	content, err := os.ReadFile(filepath.Join(t.dir, filepath.FromSlash(name)))
+	if os.IsNotExist(err) {
+		return EmptyString, false, nil
+	}
+	if err != nil {
+		return EmptyString, false, err
+	}
+
+	return string(content), true, nil
+}
+
+// ----------------------------------------------------------------
+
+// ResolveTemplatesDir the override directory of --templates: a directory, or the name of a
+// template set of ~/.liquigen/templates; ~/.liquigen/templates/default when blank, and
+// present.
+func ResolveTemplatesDir(value string) (string, error) {
+	if stringz.IsBlankString(value) {
+		dir := filepath.Join(home.Dir, TemplatesHome, DefaultTemplateSet)
+		if filez.DirExists(dir) {
+			return dir, nil
+		}
+
+		return EmptyString, nil
+	}
+
+	if info, err := os.Stat(value); err == nil && info.IsDir() {
+		return filez.Clean(value)
+	}
+
+	dir := filepath.Join(home.Dir, TemplatesHome, value)
+	if filez.DirExists(dir) {
+		return dir, nil
+	}
+
+	return EmptyString, fmt.Errorf("templates: %s is neither a directory nor a template set of %s",
+		value, filepath.Join(home.Dir, TemplatesHome))
+}
+
+// templatesOf the templates of args.
+func templatesOf(args *Args) *Templates {
+	return NewTemplates(args.Templates)
+}
+
+// ----------------------------------------------------------------
+
+// OnTemplateExport writes the embedded templates into dir, the starting point of a template
+// set, e.g. ~/.liquigen/templates/default.
+func OnTemplateExport(args *Args, dir string) {
+	dir, err := filez.Clean(dir)
+	if err != nil {
+		panic(err)
+	}
+
+	templates := NewTemplates(EmptyString)
+	output := NewOutput(args)
+
+	for _, name := range templates.Embedded() {
+		content, err := templates.EmbeddedString(name)
+		if err != nil {
+			panic(err)
+		}
+
+		output.Add(filepath.Join(dir, filepath.FromSlash(name)), []byte(content), 0o644)
+	}
+
+	flushOutput(args, output)
+
+	if !args.DryRun {
+		fmt.Println(yellow("Templates: exported ->"), cyan(fmt.Sprintf("%s(%d files)", dir, len(output.Files()))))
+	}
+}
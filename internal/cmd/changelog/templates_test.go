@@ -0,0 +1,94 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/photowey/liquigen/pkg/stringz"
+)
+
+func TestTemplates(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("liquibase-changelog/README.md.tmpl", "# custom")
+	write("liquibase-changelog/docs/CHANGES.md.tmpl", "# changes")
+	write("liquibase-changelog/notes.txt", "ignored")
+
+	templates := NewTemplates(dir)
+
+	names, err := templates.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	for _, name := range []string{"liquibase-changelog/README.md.tmpl", "liquibase-changelog/docs/CHANGES.md.tmpl", "builds/maven/pom.xml.tmpl"} {
+		if stringz.ArrayNotContains(names, name) {
+			t.Errorf("List() got = %v, want containing %s", names, name)
+		}
+	}
+	if stringz.ArrayContains(names, "liquibase-changelog/notes.txt") {
+		t.Errorf("List() got = %v, want without notes.txt", names)
+	}
+	if len(names) != len(templates.Embedded())+1 {
+		t.Errorf("List() got %d templates, want %d", len(names), len(templates.Embedded())+1)
+	}
+
+	tests := []struct {
+		name     string
+		contains string
+	}{
+		{name: "liquibase-changelog/README.md.tmpl", contains: "# custom"},
+		{name: "liquibase-changelog/docs/CHANGES.md.tmpl", contains: "# changes"},
+		{name: "builds/maven/pom.xml.tmpl", contains: "<artifactId>spring-boot-starter-parent</artifactId>"},
+	}
+	for _, tt := range tests {
+		t.Run("test "+tt.name, func(t *testing.T) {
+			got, err := templates.FindString(tt.name)
+			if err != nil {
+				t.Fatalf("FindString() error = %v", err)
+			}
+			if !strings.Contains(got, tt.contains) {
+				t.Errorf("FindString() got = %v, want containing %v", got, tt.contains)
+			}
+		})
+	}
+}
+
+func TestResolveTemplatesDir(t *testing.T) {
+	dir := t.TempDir()
+
+	got, err := ResolveTemplatesDir(dir)
+	if err != nil || got != dir {
+		t.Errorf("ResolveTemplatesDir() got = %v, %v, want %v", got, err, dir)
+	}
+
+	if _, err = ResolveTemplatesDir(filepath.Join(dir, "missing")); err == nil {
+		t.Errorf("ResolveTemplatesDir() want error for a missing template set")
+	}
+}
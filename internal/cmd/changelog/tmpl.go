@@ -18,109 +18,96 @@ package changelog
 
 import (
 	"fmt"
-	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 
-	"github.com/gobuffalo/packr/v2"
-	"github.com/photowey/liquigen/pkg/stringz"
+	"github.com/photowey/liquigen/internal/cmd/database/ast/parser/mysql"
+	"github.com/photowey/liquigen/internal/cmd/database/ast/parser/postgres"
 )
 
 const (
-	FixedNameTemplate = "template_employee_1.0.0"
-	DatetimeLayout    = "20060102"
-	TmplSuffix        = ".tmpl"
-	templatePackr2    = "changelogs"
-	templatePackr2Dir = "./templates"
+	DatetimeLayout = "20060102"
+	TmplSuffix     = ".tmpl"
 )
 
 // ----------------------------------------------------------------
 
-//go:generate packr2
-func write(ctx *Context) (err error) {
-	path := ctx.Path
-
-	box := packr.New(templatePackr2, templatePackr2Dir)
-	if err = os.MkdirAll(path, 0o755); err != nil {
-		return
-	}
+// write plans the table files of the manifest for the table of ctx.
+func write(ctx *Context, args *Args, templates *Templates, manifest *Manifest, output *Output) (err error) {
+	for _, entry := range manifest.Entries(ScopeTable, projectOf(args)) {
+		path := ctx.ChangelogFile
+		if entry.Path != EmptyString {
+			path = entry.OutputPath(args, ctx.Table.Name, ctx.Date)
+		}
 
-	err = writeTemplate(ctx, box)
-	if err != nil {
-		return err
+		if err = writeEntry(ctx, templates, entry, path, output); err != nil {
+			return
+		}
 	}
 
 	return
 }
 
-func writeNormal(ctx *Args) (err error) {
-	path := ctx.Path
-
-	box := packr.New(templatePackr2, templatePackr2Dir)
-	if err = os.MkdirAll(path, 0o755); err != nil {
-		return
+// writeNormal plans the project and dialect files of the manifest.
+func writeNormal(args *Args, templates *Templates, manifest *Manifest, output *Output) (err error) {
+	ctx, err := newProjectContext(args)
+	if err != nil {
+		return err
 	}
 
-	for _, item := range box.List() {
-		tmpl, _ := box.FindString(item)
-
-		tmpItem := item
-
-		if testIsTargetTmplFile(tmpItem) {
-			continue
+	for _, entry := range manifest.Entries(ScopeProject, ctx.Project) {
+		if err = writeEntry(ctx, templates, entry, entry.OutputPath(args, EmptyString, ctx.Date), output); err != nil {
+			return
 		}
+	}
+
+	for _, entry := range manifest.Entries(ScopeDialect, ctx.Project) {
+		for _, dialect := range entry.dialects() {
+			dialectArgs := *args
+			dialectArgs.Dialect = dialect
 
-		i := strings.LastIndex(tmpItem, string(os.PathSeparator))
-		if i > 0 {
-			dir := tmpItem[:i]
-			if err = os.MkdirAll(filepath.Join(path, dir), 0o755); err != nil {
-				return
+			dialectCtx, err := newProjectContext(&dialectArgs)
+			if err != nil {
+				return err
 			}
-		}
 
-		tmpItem = strings.TrimSuffix(tmpItem, TmplSuffix)
-		if err = doWriteOriginalFile(item, ctx.Cwd, filepath.Join(path, tmpItem), tmpl); err != nil {
-			return
+			path := entry.OutputPath(&dialectArgs, EmptyString, dialectCtx.Date)
+			if err = writeEntry(dialectCtx, templates, entry, path, output); err != nil {
+				return err
+			}
 		}
 	}
 
 	return
 }
 
-func writeTemplate(ctx *Context, box *packr.Box) (err error) {
-	path := ctx.Path
-
-	for _, item := range box.List() {
-		tmpl, _ := box.FindString(item)
-
-		tmpItem := item
-		if testIsNotTargetTmplFile(item) {
-			continue
-		}
+// writeVersion plans the version files of the manifest, once the changelogs of the version
+// are planned into output.
+func writeVersion(args *Args, templates *Templates, manifest *Manifest, output *Output) (err error) {
+	ctx, err := newProjectContext(args)
+	if err != nil {
+		return err
+	}
 
-		// template_employee_1.0.0.xml.tmpl
-		i := strings.LastIndex(tmpItem, string(os.PathSeparator))
-		if i > 0 {
-			dir := tmpItem[:i]
+	changelogs := output.Files()
+	for _, entry := range manifest.Entries(ScopeVersion, ctx.Project) {
+		path := entry.OutputPath(args, EmptyString, ctx.Date)
 
-			tmpItem = strings.ReplaceAll(
-				tmpItem,
-				stringz.ToProjectPath(FixedNameTemplate),
-				stringz.ToProjectPath(fmt.Sprintf("%s_%s", ctx.Table.Name, ctx.Version)))
+		ctx.Changelogs = nil
+		for _, file := range changelogs {
+			if !file.Changelog {
+				continue
+			}
 
-			if err = os.MkdirAll(filepath.Join(path, dir), 0o755); err != nil {
-				return
+			rel, err := filepath.Rel(filepath.Dir(path), file.Path)
+			if err != nil {
+				return err
 			}
+			ctx.Changelogs = append(ctx.Changelogs, filepath.ToSlash(rel))
 		}
 
-		tmpItem = strings.ReplaceAll(
-			tmpItem,
-			stringz.ToProjectPath(FixedNameTemplate),
-			stringz.ToProjectPath(fmt.Sprintf("%s_%s", ctx.Table.Name, ctx.Version)))
-
-		tmpItem = strings.TrimSuffix(tmpItem, TmplSuffix)
-		if err = doWriteFile(ctx, item, filepath.Join(path, tmpItem), tmpl); err != nil {
+		if err = writeEntry(ctx, templates, entry, path, output); err != nil {
 			return
 		}
 	}
@@ -128,59 +115,73 @@ func writeTemplate(ctx *Context, box *packr.Box) (err error) {
 	return
 }
 
-func testIsTargetTmplFile(target string) bool {
-	return strings.Contains(target, FixedNameTemplate)
-}
-
-func testIsNotTargetTmplFile(target string) bool {
-	return !testIsTargetTmplFile(target)
-}
-
-func doWriteFile(ctx *Context, item, path, tmpl string) (err error) {
-	content, err := tryParseIfNecessary(ctx, item, tmpl)
+// writeEntry plans the file of entry into path, rendered with ctx when the entry is.
+func writeEntry(ctx *Context, templates *Templates, entry *ManifestEntry, path string, output *Output) error {
+	tmpl, err := templates.FindString(entry.Template)
 	if err != nil {
-		return
+		return fmt.Errorf("manifest: find the template %s failed: %v", entry.Template, err)
 	}
 
-	// Twice?
-	// fmt.Println(yellow("File: generated ->"), cyan("$pwd"+path[len(ctx.Cwd):]))
+	content := tmpl
+	if entry.Render {
+		bytes, err := parseTmpl(ctx, tmpl)
+		if err != nil {
+			return fmt.Errorf("manifest: render the template %s failed: %v", entry.Template, err)
+		}
+		content = string(bytes)
+	}
 
 	content = replaceSpace(content)
+	if entry.Scope == ScopeTable {
+		output.AddChangelog(path, []byte(content), 0o755)
+	} else {
+		output.Add(path, []byte(content), 0o755)
+	}
 
-	return os.WriteFile(path, []byte(content), 0o755)
+	return nil
 }
 
-func doWriteOriginalFile(item, cwd, path, tmpl string) (err error) {
-	content, err := tryParseIfNecessaryOriginal(item, tmpl)
+// newProjectContext the context of the scaffold templates, rendered once per project.
+func newProjectContext(args *Args) (*Context, error) {
+	project := projectOf(args)
+	driver, err := project.Driver(args.Dialect)
 	if err != nil {
-		return
+		return nil, err
 	}
 
-	// Twice?
-	// fmt.Println(yellow("Original File: generated ->"), cyan("$pwd"+path[len(cwd):]))
-
-	content = replaceSpace(content)
-
-	return os.WriteFile(path, []byte(content), 0o755)
+	ctx := NewContext()
+	ctx.Author = args.Author
+	ctx.Version = args.Version
+	ctx.Date = generationDate(args).Format(DatetimeLayout)
+	ctx.Dialect = args.Dialect
+	ctx.MySQL = mysql.Dialect
+	ctx.Postgres = postgres.Dialect
+	ctx.Cwd = args.Cwd
+	ctx.Path = args.Path
+	ctx.Project = project
+	ctx.Driver = driver
+	ctx.Datasource = newDatasource(args)
+	ctx.MasterChangelog, ctx.MasterLocation = masterChangelog(args)
+
+	return ctx, nil
 }
 
-func tryParseIfNecessary(ctx *Context, item, tmpl string) (string, error) {
-	return doParseIfNecessary(ctx, item, tmpl)
-}
+// masterChangelog the master changelog of the output layout, relative to the resources of
+// the project, the classpath root, else to the project, and its Spring resource location.
+func masterChangelog(args *Args) (string, string) {
+	layout := outputLayout(args)
+	master := layout.MasterFile(args)
 
-func tryParseIfNecessaryOriginal(item, tmpl string) (string, error) {
-	return doParseIfNecessary(NewContext(), item, tmpl)
-}
-
-func doParseIfNecessary(ctx *Context, item, tmpl string) (string, error) {
-	if testIsTargetTmplFile(item) {
-		bytes, err := parseTmpl(ctx, tmpl)
-		if err != nil {
-			return EmptyString, err
+	if rel, err := filepath.Rel(layout.ProjectFile(args, ResourcesDir), master); err == nil {
+		if rel = filepath.ToSlash(rel); !strings.HasPrefix(rel, "../") {
+			return rel, "classpath:" + rel
 		}
-		return string(bytes), nil
 	}
-	return tmpl, nil
+	if rel, err := filepath.Rel(layout.ProjectFile(args, EmptyString), master); err == nil {
+		return filepath.ToSlash(rel), "file:" + filepath.ToSlash(rel)
+	}
+
+	return filepath.ToSlash(master), "file:" + filepath.ToSlash(master)
 }
 
 func replaceSpace(content string) string {
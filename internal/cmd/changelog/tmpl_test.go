@@ -0,0 +1,117 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changelog
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteNormal(t *testing.T) {
+	project := DefaultProject()
+	project.Build = BuildGradle
+	project.Package = "com.acme.billing"
+
+	args := &Args{
+		Path:     "/repo",
+		Author:   "dev",
+		Dialect:  "mysql",
+		Host:     "db",
+		Database: "billing",
+		Username: "app",
+		Project:  project,
+	}
+
+	templates := NewTemplates(EmptyString)
+	manifest, err := readManifest(templates)
+	if err != nil {
+		t.Fatalf("readManifest() error = %v", err)
+	}
+
+	output := NewOutput(args)
+	if err = writeNormal(args, templates, manifest, output); err != nil {
+		t.Fatalf("writeNormal() error = %v", err)
+	}
+
+	files := make(map[string]string)
+	for _, file := range output.Files() {
+		files[filepath.ToSlash(strings.TrimPrefix(file.Path, filepath.FromSlash("/repo/liquibase-changelog/")))] = string(file.Content)
+	}
+
+	tests := []struct {
+		name     string
+		contains string
+	}{
+		{name: "build.gradle.kts", contains: `runtimeOnly("mysql:mysql-connector-java")`},
+		{name: "build.gradle.kts", contains: `"changelogFile" to "liquibase/mysql/master.xml"`},
+		{name: "settings.gradle.kts", contains: `rootProject.name = "liquibase-changelog"`},
+		{name: "liquibase.properties", contains: "url=jdbc:mysql://db:3306/billing"},
+		{name: "src/main/java/com/acme/billing/App.java", contains: "package com.acme.billing;"},
+		{name: "src/main/resources/application.yml", contains: `change-log: "classpath:liquibase/mysql/master.xml"`},
+		{name: "src/main/resources/application-dev.yml", contains: `username: "${DATASOURCE_USERNAME:app}"`},
+		{name: "src/main/resources/application-prod.yml", contains: `url: "${DATASOURCE_URL}"`},
+	}
+	for _, tt := range tests {
+		t.Run("test "+tt.name, func(t *testing.T) {
+			content, ok := files[tt.name]
+			if !ok {
+				t.Fatalf("writeNormal() missing %s", tt.name)
+			}
+			if !strings.Contains(content, tt.contains) {
+				t.Errorf("writeNormal() %s got = %s, want containing %s", tt.name, content, tt.contains)
+			}
+		})
+	}
+
+	if _, ok := files["pom.xml"]; ok {
+		t.Errorf("writeNormal() got pom.xml in the gradle scaffold")
+	}
+}
+
+func TestMasterChangelog(t *testing.T) {
+	tests := []struct {
+		name         string
+		layout       *Layout
+		want         string
+		wantLocation string
+	}{
+		{name: "test default layout", layout: DefaultLayout(), want: "liquibase/mysql/master.xml", wantLocation: "classpath:liquibase/mysql/master.xml"},
+		{
+			name:         "test resources master",
+			layout:       &Layout{Project: "app", Master: "app/src/main/resources/db/changelog/db.changelog-{{dialect}}.xml"},
+			want:         "db/changelog/db.changelog-mysql.xml",
+			wantLocation: "classpath:db/changelog/db.changelog-mysql.xml",
+		},
+		{
+			name:         "test project master",
+			layout:       &Layout{Project: "app", Master: "app/db/{{dialect}}/master.xml"},
+			want:         "db/mysql/master.xml",
+			wantLocation: "file:db/mysql/master.xml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := &Args{Path: "/repo", Dialect: "mysql", Version: "1.0.1", Layout: tt.layout}
+
+			got, gotLocation := masterChangelog(args)
+			if got != tt.want || gotLocation != tt.wantLocation {
+				t.Errorf("masterChangelog() = %v, %v, want %v, %v", got, gotLocation, tt.want, tt.wantLocation)
+			}
+		})
+	}
+}
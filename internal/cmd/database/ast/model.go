@@ -17,39 +17,40 @@
 package ast
 
 type Database struct {
-	Name   string
-	Tables []*Table
+	Name   string   `json:"name" yaml:"name"`
+	Tables []*Table `json:"tables" yaml:"tables"`
 }
 
 type Table struct {
-	Database        string
-	Name            string
-	Comment         string
-	CreateStatement bool
-	AlterStatement  bool
-	Columns         []*Column
-	Indexes         []*Index
+	Database        string    `json:"database,omitempty" yaml:"database,omitempty"`
+	Name            string    `json:"name" yaml:"name"`
+	Comment         string    `json:"comment,omitempty" yaml:"comment,omitempty"`
+	CreateStatement bool      `json:"-" yaml:"-"`
+	AlterStatement  bool      `json:"-" yaml:"-"`
+	Columns         []*Column `json:"columns" yaml:"columns"`
+	Indexes         []*Index  `json:"indexes,omitempty" yaml:"indexes,omitempty"`
 }
 
 type Column struct {
-	Name            string
-	DataType        string
-	Length          *int
-	Precision       *int
-	Scale           *int
-	NotNull         bool
-	AutoIncrement   bool
-	PrimaryKey      bool
-	ForeignKey      bool
-	Unique          bool
-	Unsigned        bool
-	Zerofill        bool
-	UpdateTimestamp bool
-	Default         string
-	Comment         string
+	Name            string `json:"name" yaml:"name"`
+	DataType        string `json:"dataType" yaml:"dataType"`
+	Length          *int   `json:"length,omitempty" yaml:"length,omitempty"`
+	Precision       *int   `json:"precision,omitempty" yaml:"precision,omitempty"`
+	Scale           *int   `json:"scale,omitempty" yaml:"scale,omitempty"`
+	NotNull         bool   `json:"notNull,omitempty" yaml:"notNull,omitempty"`
+	AutoIncrement   bool   `json:"autoIncrement,omitempty" yaml:"autoIncrement,omitempty"`
+	PrimaryKey      bool   `json:"primaryKey,omitempty" yaml:"primaryKey,omitempty"`
+	ForeignKey      bool   `json:"foreignKey,omitempty" yaml:"foreignKey,omitempty"`
+	Unique          bool   `json:"unique,omitempty" yaml:"unique,omitempty"`
+	Unsigned        bool   `json:"unsigned,omitempty" yaml:"unsigned,omitempty"`
+	Zerofill        bool   `json:"zerofill,omitempty" yaml:"zerofill,omitempty"`
+	UpdateTimestamp bool   `json:"updateTimestamp,omitempty" yaml:"updateTimestamp,omitempty"`
+	Default         string `json:"default,omitempty" yaml:"default,omitempty"`
+	Comment         string `json:"comment,omitempty" yaml:"comment,omitempty"`
 }
 
 type Index struct {
-	Name    string
-	Columns []string
+	Name    string   `json:"name" yaml:"name"`
+	Unique  bool     `json:"unique,omitempty" yaml:"unique,omitempty"`
+	Columns []string `json:"columns" yaml:"columns"`
 }
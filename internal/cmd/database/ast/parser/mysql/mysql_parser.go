@@ -24,6 +24,7 @@ import (
 	"github.com/photowey/liquigen/internal/cmd/database/ast"
 	"github.com/photowey/liquigen/internal/cmd/database/ast/lexer"
 	"github.com/photowey/liquigen/internal/cmd/database/ast/parser"
+	"github.com/photowey/liquigen/internal/cmd/database/types"
 	"github.com/photowey/liquigen/pkg/stringz"
 )
 
@@ -279,7 +280,7 @@ func tryParse(tokenizer *ast.Tokenizer) (*ast.Table, error) {
 				tokenizer.Next() // TABLE
 
 				tab := tokenizer.Next() // table_name
-				table.Name = tab.Literal
+				table.Name = stringz.RemoveQuotes(tab.Literal)
 
 				tokenizer.Next() // COMMENT
 
@@ -333,11 +334,11 @@ OUTER:
 
 	// Table name
 	tokenTableName := tokenizer.Next()
-	table.Name = tokenTableName.Literal
+	table.Name = stringz.RemoveQuotes(tokenTableName.Literal)
 	if strings.Contains(table.Name, ".") {
-		pair := strings.Split(table.Name, ".")
-		table.Database = pair[0]
-		table.Name = pair[1]
+		pair := strings.Split(tokenTableName.Literal, ".")
+		table.Database = stringz.RemoveQuotes(pair[0])
+		table.Name = stringz.RemoveQuotes(pair[1])
 	}
 
 	// (
@@ -348,27 +349,37 @@ OUTER:
 			break
 		}
 
+		// PRIMARY KEY | UNIQUE KEY | KEY | INDEX | CONSTRAINT | FOREIGN KEY ...
+		if predicateIsConstraintToken(tokenizer.Peek()) {
+			tryParseConstraint(tokenizer, table)
+
+			if tokenizer.Peek().Type == lexer.TokenComma {
+				tokenizer.Next() // ','
+			}
+
+			continue
+		}
+
 		column := &ast.Column{
-			Name:     tokenizer.Next().Literal, // Name
-			DataType: tokenizer.Next().Literal, // Data type
+			Name:     stringz.RemoveQuotes(tokenizer.Next().Literal), // Name
+			DataType: tokenizer.Next().Literal,                       // Data type
 		}
 
 		// Length | Precision | Scale
 		if tokenizer.Peek().Type == lexer.TokenLeftParen {
 			tokenizer.Next()                      // '('
-			length := ast.ToInt(tokenizer.Next()) // Length
+			length := ast.ToInt(tokenizer.Next()) // Length | Precision
 			column.Length = length
 
 			if tokenizer.Peek().Type == lexer.TokenComma {
-				tokenizer.Next()                         // ','
-				precision := ast.ToInt(tokenizer.Next()) // Precision
-				column.Precision = precision
-
-				if tokenizer.Peek().Type == lexer.TokenComma {
-					tokenizer.Next()                     // 跳过 ','
-					scale := ast.ToInt(tokenizer.Next()) // Scale
-					column.Scale = scale
-				}
+				tokenizer.Next()                     // ','
+				scale := ast.ToInt(tokenizer.Next()) // Scale
+				column.Precision = length
+				column.Scale = scale
+				column.Length = nil
+			} else if strings.ToLower(column.DataType) == types.DECIMAL {
+				column.Precision = length
+				column.Length = nil
 			}
 			tokenizer.Next() // ')'
 		}
@@ -450,3 +461,91 @@ OUTER:
 
 	return table, nil
 }
+
+func predicateIsConstraintToken(token ast.Token) bool {
+	switch token.Type {
+	case lexer.TokenPrimary, lexer.TokenUnique, lexer.TokenKey, lexer.TokenIndex,
+		lexer.TokenConstraint, lexer.TokenForeign, lexer.TokenCheck:
+		return true
+	default:
+		return false
+	}
+}
+
+// tryParseConstraint parses a table level constraint up to the next ',' or the closing ')'
+// of the table definition.
+//
+// PRIMARY KEY (a, b) marks the primary key columns, [UNIQUE] KEY|INDEX name (a, b) adds an index,
+// the other constraints are skipped.
+func tryParseConstraint(tokenizer *ast.Tokenizer, table *ast.Table) {
+	var (
+		primary bool
+		index   *ast.Index
+	)
+
+	switch tokenizer.Peek().Type {
+	case lexer.TokenPrimary:
+		primary = true
+		tokenizer.Next() // 'PRIMARY'
+		if tokenizer.Peek().Type == lexer.TokenKey {
+			tokenizer.Next() // 'KEY'
+		}
+	case lexer.TokenUnique, lexer.TokenKey, lexer.TokenIndex:
+		index = &ast.Index{}
+		if tokenizer.Peek().Type == lexer.TokenUnique {
+			tokenizer.Next() // 'UNIQUE'
+			index.Unique = true
+		}
+		if tokenizer.Peek().Type == lexer.TokenKey || tokenizer.Peek().Type == lexer.TokenIndex {
+			tokenizer.Next() // 'KEY' | 'INDEX'
+		}
+		if tokenizer.Peek().Type == lexer.TokenIdentifier {
+			index.Name = stringz.RemoveQuotes(tokenizer.Next().Literal) // Name
+		}
+	}
+
+	var columns []string
+
+	depth := 0
+	for tokenizer.HasNext() {
+		token := tokenizer.Peek()
+		if depth == 0 && (token.Type == lexer.TokenComma || token.Type == lexer.TokenRightParen) {
+			break
+		}
+
+		tokenizer.Next()
+
+		switch token.Type {
+		case lexer.TokenLeftParen:
+			depth++
+		case lexer.TokenRightParen:
+			depth--
+		case lexer.TokenEOF:
+			return
+		default:
+			// Column names, skipping the prefix lengths: (name(10), code)
+			if depth == 1 && token.Type != lexer.TokenComma && ast.ToInt(token) == nil {
+				columns = append(columns, stringz.RemoveQuotes(token.Literal))
+			}
+		}
+	}
+
+	if primary {
+		for _, column := range table.Columns {
+			if stringz.ArrayContains(columns, column.Name) {
+				column.PrimaryKey = true
+				column.NotNull = true
+			}
+		}
+
+		return
+	}
+
+	if index != nil && len(columns) > 0 {
+		if stringz.IsBlankString(index.Name) {
+			index.Name = columns[0]
+		}
+		index.Columns = columns
+		table.Indexes = append(table.Indexes, index)
+	}
+}
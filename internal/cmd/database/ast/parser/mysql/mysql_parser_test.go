@@ -202,3 +202,81 @@ create table if not exists company.employee
 		})
 	}
 }
+
+func TestParser_parse_constraints(t *testing.T) {
+	sql := `CREATE TABLE IF NOT EXISTS company.employee
+(
+    id          BIGINT         NOT NULL COMMENT 'ID',
+    employee_no VARCHAR(32)    NOT NULL COMMENT 'No',
+    org_id      BIGINT         NOT NULL COMMENT 'Org',
+    balance     DECIMAL(16, 2) NOT NULL COMMENT 'Balance',
+    amount      DECIMAL(12)    NOT NULL COMMENT 'Amount',
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_employee_no (employee_no),
+    KEY idx_org (org_id, employee_no(8)) USING BTREE,
+    CONSTRAINT fk_org FOREIGN KEY (org_id) REFERENCES organization (id)
+) COMMENT = 'EMPLOYEE' ENGINE = Innodb;
+`
+
+	got, err := parse(sql)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	table := got.Database.Tables[0]
+	if len(table.Columns) != 5 {
+		t.Fatalf("Parse() got %d columns, want 5", len(table.Columns))
+	}
+	if !table.Columns[0].PrimaryKey {
+		t.Errorf("Parse() got id not primary key")
+	}
+
+	balance := table.Columns[3]
+	if balance.Length != nil || *balance.Precision != 16 || *balance.Scale != 2 {
+		t.Errorf("Parse() got balance length = %v, precision = %v, scale = %v", balance.Length, *balance.Precision, *balance.Scale)
+	}
+	amount := table.Columns[4]
+	if amount.Length != nil || *amount.Precision != 12 || amount.Scale != nil {
+		t.Errorf("Parse() got amount length = %v, precision = %v, scale = %v", amount.Length, *amount.Precision, amount.Scale)
+	}
+
+	want := []*ast.Index{
+		{Name: "uk_employee_no", Unique: true, Columns: []string{"employee_no"}},
+		{Name: "idx_org", Columns: []string{"org_id", "employee_no"}},
+	}
+	if !reflect.DeepEqual(table.Indexes, want) {
+		t.Errorf("Parse() got indexes = %v, want %v", table.Indexes, want)
+	}
+}
+
+func TestParser_parse_quoted(t *testing.T) {
+	sql := "CREATE TABLE `company`.`order`\n" +
+		"(\n" +
+		"    `id`   BIGINT      NOT NULL COMMENT 'ID',\n" +
+		"    `user` VARCHAR(32) NOT NULL COMMENT 'User',\n" +
+		"    PRIMARY KEY (`id`),\n" +
+		"    KEY `idx_user` (`user`)\n" +
+		") COMMENT = 'ORDER' ENGINE = Innodb;\n" +
+		"ALTER TABLE `order` COMMENT 'ORDERS';\n"
+
+	got, err := parse(sql)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	table := got.Database.Tables[0]
+	if table.Database != "company" || table.Name != "order" || table.Comment != "ORDERS" {
+		t.Errorf("Parse() got table = %s.%s(%s), want company.order(ORDERS)", table.Database, table.Name, table.Comment)
+	}
+	if table.Columns[0].Name != "id" || table.Columns[1].Name != "user" {
+		t.Errorf("Parse() got columns = %s, %s, want id, user", table.Columns[0].Name, table.Columns[1].Name)
+	}
+	if !table.Columns[0].PrimaryKey {
+		t.Errorf("Parse() got id not primary key")
+	}
+
+	want := []*ast.Index{{Name: "idx_user", Columns: []string{"user"}}}
+	if !reflect.DeepEqual(table.Indexes, want) {
+		t.Errorf("Parse() got indexes = %v, want %v", table.Indexes, want)
+	}
+}
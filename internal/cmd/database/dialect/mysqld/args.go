@@ -16,6 +16,32 @@
 
 package mysqld
 
-func MySQL() {
-	panic("not implemented")
+import (
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/photowey/liquigen/internal/cmd/database"
+)
+
+// dsn the data source name of the database, formatted by the driver so the credentials
+// need no escaping.
+func dsn(args *database.Args) string {
+	config := mysql.NewConfig()
+	config.User = args.Username
+	config.Passwd = args.Password
+	config.Net = "tcp"
+	config.Addr = net.JoinHostPort(args.Host, strconv.Itoa(args.Port))
+	config.DBName = args.Database
+
+	query := url.Values{}
+	query.Set("charset", Charset)
+	query.Set("parseTime", "true")
+	query.Set("loc", "Local")
+	for key, value := range args.Params {
+		query.Set(key, value)
+	}
+
+	return config.FormatDSN() + "?" + query.Encode()
 }
@@ -0,0 +1,66 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mysqld
+
+import (
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/photowey/liquigen/internal/cmd/database"
+)
+
+func TestDsn(t *testing.T) {
+	tests := []struct {
+		name string
+		args *database.Args
+		want string
+	}{
+		{
+			name: "Test dsn()",
+			args: &database.Args{Host: "127.0.0.1", Port: 3306, Username: "root", Password: "root", Database: "hello_world"},
+			want: "root:root@tcp(127.0.0.1:3306)/hello_world?charset=utf8mb4&loc=Local&parseTime=true",
+		},
+		{
+			name: "Test dsn()-params",
+			args: &database.Args{
+				Host: "127.0.0.1", Port: 3306, Username: "root", Password: "root", Database: "hello_world",
+				Params: map[string]string{"tls": "true", "loc": "UTC"},
+			},
+			want: "root:root@tcp(127.0.0.1:3306)/hello_world?charset=utf8mb4&loc=UTC&parseTime=true&tls=true",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dsn(tt.args); got != tt.want {
+				t.Errorf("dsn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDsn_credentials(t *testing.T) {
+	args := &database.Args{Host: "127.0.0.1", Port: 3306, Username: "root", Password: "p@ss:w/rd?#", Database: "hello_world"}
+
+	config, err := mysql.ParseDSN(dsn(args))
+	if err != nil {
+		t.Fatalf("dsn() = %v, not parsed: %v", dsn(args), err)
+	}
+	if config.User != args.Username || config.Passwd != args.Password || config.DBName != args.Database {
+		t.Errorf("dsn() parsed = %s:%s/%s, want %s:%s/%s",
+			config.User, config.Passwd, config.DBName, args.Username, args.Password, args.Database)
+	}
+}
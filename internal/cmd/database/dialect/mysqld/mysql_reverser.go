@@ -0,0 +1,232 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mysqld
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/photowey/liquigen/internal/cmd/database"
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	Dialect = "mysql"
+	Driver  = "mysql"
+
+	AutoIncrementExtra = "auto_increment"
+	OnUpdateExtra      = "on update"
+	CurrentTimestamp   = "CURRENT_TIMESTAMP"
+	PrimaryKey         = "PRI"
+	UniqueKey          = "UNI"
+	Nullable           = "YES"
+	Unsigned           = "unsigned"
+	Zerofill           = "zerofill"
+)
+
+// ----------------------------------------------------------------
+
+var _ database.Reverser = (*Reverser)(nil)
+
+// ----------------------------------------------------------------
+
+func init() {
+	database.RegisterReverser(NewReverser())
+}
+
+// ----------------------------------------------------------------
+
+type Reverser struct {
+	dialect string
+}
+
+// ----------------------------------------------------------------
+
+func NewReverser() database.Reverser {
+	return &Reverser{
+		dialect: Dialect,
+	}
+}
+
+// ----------------------------------------------------------------
+
+func (r Reverser) Dialect() string {
+	return r.dialect
+}
+
+func (r Reverser) Driver() string {
+	return Driver
+}
+
+func (r Reverser) DSN(args *database.Args) string {
+	return dsn(args)
+}
+
+func (r Reverser) Tables(ctx context.Context, db *sql.DB, databaseName string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, TableInfoSQL, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+
+		tables = append(tables, name)
+	}
+
+	return tables, rows.Err()
+}
+
+func (r Reverser) Table(ctx context.Context, db *sql.DB, databaseName, tableName string) (*ast.Table, error) {
+	table := &ast.Table{
+		Database:        databaseName,
+		Name:            tableName,
+		CreateStatement: true,
+	}
+
+	if err := db.QueryRowContext(ctx, TableCommentSQL, databaseName, tableName).Scan(&table.Comment); err != nil {
+		return nil, err
+	}
+
+	columns, err := r.columns(ctx, db, databaseName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	table.Columns = columns
+
+	indexes, err := r.indexes(ctx, db, databaseName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	table.Indexes = indexes
+
+	return table, nil
+}
+
+// ----------------------------------------------------------------
+
+func (r Reverser) columns(ctx context.Context, db *sql.DB, databaseName, tableName string) ([]*ast.Column, error) {
+	rows, err := db.QueryContext(ctx, ColumnInfoSQL, databaseName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []*ast.Column
+	for rows.Next() {
+		var (
+			name, dataType, columnType string
+			length, precision, scale   sql.NullInt64
+			nullable, columnKey, extra string
+			defaultValue               sql.NullString
+			comment                    string
+		)
+
+		if err = rows.Scan(
+			&name, &dataType, &columnType,
+			&length, &precision, &scale,
+			&nullable, &columnKey, &extra,
+			&defaultValue, &comment,
+		); err != nil {
+			return nil, err
+		}
+
+		column := &ast.Column{
+			Name:          name,
+			DataType:      strings.ToLower(dataType),
+			NotNull:       nullable != Nullable,
+			AutoIncrement: strings.Contains(strings.ToLower(extra), AutoIncrementExtra),
+			PrimaryKey:    columnKey == PrimaryKey,
+			Unique:        columnKey == UniqueKey,
+			Unsigned:      strings.Contains(strings.ToLower(columnType), Unsigned),
+			Zerofill:      strings.Contains(strings.ToLower(columnType), Zerofill),
+			Default:       defaultValue.String,
+			Comment:       comment,
+		}
+
+		if strings.Contains(strings.ToLower(extra), OnUpdateExtra) {
+			column.UpdateTimestamp = true
+			column.Default = CurrentTimestamp
+		}
+
+		if length.Valid {
+			column.Length = toIntPtr(length.Int64)
+		}
+		if precision.Valid {
+			column.Precision = toIntPtr(precision.Int64)
+		}
+		if scale.Valid {
+			column.Scale = toIntPtr(scale.Int64)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, rows.Err()
+}
+
+func (r Reverser) indexes(ctx context.Context, db *sql.DB, databaseName, tableName string) ([]*ast.Index, error) {
+	rows, err := db.QueryContext(ctx, IndexInfoSQL, databaseName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var indexes []*ast.Index
+	for rows.Next() {
+		var (
+			name, column string
+			nonUnique    int
+		)
+
+		if err = rows.Scan(&name, &nonUnique, &column); err != nil {
+			return nil, err
+		}
+
+		// Rows are ordered by INDEX_NAME, SEQ_IN_INDEX
+		if n := len(indexes); n > 0 && indexes[n-1].Name == name {
+			indexes[n-1].Columns = append(indexes[n-1].Columns, column)
+
+			continue
+		}
+
+		indexes = append(indexes, &ast.Index{
+			Name:    name,
+			Unique:  nonUnique == 0,
+			Columns: []string{column},
+		})
+	}
+
+	return indexes, rows.Err()
+}
+
+// ----------------------------------------------------------------
+
+func toIntPtr(x int64) *int {
+	v := int(x)
+
+	return &v
+}
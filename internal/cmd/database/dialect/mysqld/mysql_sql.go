@@ -15,3 +15,43 @@
  */
 
 package mysqld
+
+const (
+	Charset = "utf8mb4"
+
+	TableInfoSQL = `SELECT TABLE_NAME
+FROM information_schema.TABLES
+WHERE TABLE_SCHEMA = ?
+  AND TABLE_TYPE = 'BASE TABLE'
+ORDER BY TABLE_NAME`
+
+	TableCommentSQL = `SELECT TABLE_COMMENT
+FROM information_schema.TABLES
+WHERE TABLE_SCHEMA = ?
+  AND TABLE_NAME = ?`
+
+	ColumnInfoSQL = `SELECT COLUMN_NAME,
+       DATA_TYPE,
+       COLUMN_TYPE,
+       CHARACTER_MAXIMUM_LENGTH,
+       NUMERIC_PRECISION,
+       NUMERIC_SCALE,
+       IS_NULLABLE,
+       COLUMN_KEY,
+       EXTRA,
+       COLUMN_DEFAULT,
+       COLUMN_COMMENT
+FROM information_schema.COLUMNS
+WHERE TABLE_SCHEMA = ?
+  AND TABLE_NAME = ?
+ORDER BY ORDINAL_POSITION`
+
+	IndexInfoSQL = `SELECT INDEX_NAME,
+       NON_UNIQUE,
+       COLUMN_NAME
+FROM information_schema.STATISTICS
+WHERE TABLE_SCHEMA = ?
+  AND TABLE_NAME = ?
+  AND INDEX_NAME <> 'PRIMARY'
+ORDER BY INDEX_NAME, SEQ_IN_INDEX`
+)
@@ -16,6 +16,36 @@
 
 package postgresd
 
-func MySQL() {
-	panic("not implemented")
+import (
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/photowey/liquigen/internal/cmd/database"
+)
+
+const (
+	Scheme   = "postgresql"
+	TimeZone = "UTC"
+)
+
+// dsn the connection URL of the database, the credentials escaped as its userinfo.
+//
+// The TLS is left to the sslmode of the params, lib/pq requiring it by default.
+func dsn(args *database.Args) string {
+	query := url.Values{}
+	query.Set("TimeZone", TimeZone)
+	for key, value := range args.Params {
+		query.Set(key, value)
+	}
+
+	u := url.URL{
+		Scheme:   Scheme,
+		User:     url.UserPassword(args.Username, args.Password),
+		Host:     net.JoinHostPort(args.Host, strconv.Itoa(args.Port)),
+		Path:     "/" + args.Database,
+		RawQuery: query.Encode(),
+	}
+
+	return u.String()
 }
@@ -0,0 +1,274 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgresd
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	_ "github.com/lib/pq"
+	"github.com/photowey/liquigen/internal/cmd/database"
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/internal/cmd/database/types"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	Dialect = "postgres"
+	Driver  = "postgres"
+
+	Nullable        = "YES"
+	Identity        = "YES"
+	SequenceDefault = "nextval("
+)
+
+// ----------------------------------------------------------------
+
+var _ database.Reverser = (*Reverser)(nil)
+
+// udtTypes maps the Postgres udt_name to the data types liquigen generates.
+var udtTypes = map[string]string{
+	"int8":        types.BIGINT,
+	"int4":        types.INT,
+	"int2":        types.SMALLINT,
+	"float4":      types.FLOAT,
+	"float8":      types.DOUBLE,
+	"numeric":     types.DECIMAL,
+	"bpchar":      types.CHAR,
+	"varchar":     types.VARCHAR,
+	"text":        types.TEXT,
+	"date":        types.DATE,
+	"time":        types.TIME,
+	"timetz":      types.TIME,
+	"timestamp":   types.TIMESTAMP,
+	"timestamptz": types.TIMESTAMP,
+}
+
+// ----------------------------------------------------------------
+
+func init() {
+	database.RegisterReverser(NewReverser())
+}
+
+// ----------------------------------------------------------------
+
+type Reverser struct {
+	dialect string
+}
+
+// ----------------------------------------------------------------
+
+func NewReverser() database.Reverser {
+	return &Reverser{
+		dialect: Dialect,
+	}
+}
+
+// ----------------------------------------------------------------
+
+func (r Reverser) Dialect() string {
+	return r.dialect
+}
+
+func (r Reverser) Driver() string {
+	return Driver
+}
+
+func (r Reverser) DSN(args *database.Args) string {
+	return dsn(args)
+}
+
+func (r Reverser) Tables(ctx context.Context, db *sql.DB, databaseName string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, TableInfoSQL, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+
+		tables = append(tables, name)
+	}
+
+	return tables, rows.Err()
+}
+
+func (r Reverser) Table(ctx context.Context, db *sql.DB, databaseName, tableName string) (*ast.Table, error) {
+	table := &ast.Table{
+		Database:        databaseName,
+		Name:            tableName,
+		CreateStatement: true,
+	}
+
+	if err := db.QueryRowContext(ctx, TableCommentSQL, tableName).Scan(&table.Comment); err != nil {
+		return nil, err
+	}
+
+	primaryKeys, err := r.primaryKeys(ctx, db, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := r.columns(ctx, db, tableName, primaryKeys)
+	if err != nil {
+		return nil, err
+	}
+	table.Columns = columns
+
+	indexes, err := r.indexes(ctx, db, tableName)
+	if err != nil {
+		return nil, err
+	}
+	table.Indexes = indexes
+
+	return table, nil
+}
+
+// ----------------------------------------------------------------
+
+func (r Reverser) primaryKeys(ctx context.Context, db *sql.DB, tableName string) (map[string]bool, error) {
+	rows, err := db.QueryContext(ctx, PrimaryKeySQL, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	primaryKeys := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+
+		primaryKeys[name] = true
+	}
+
+	return primaryKeys, rows.Err()
+}
+
+func (r Reverser) columns(ctx context.Context, db *sql.DB, tableName string, primaryKeys map[string]bool) ([]*ast.Column, error) {
+	rows, err := db.QueryContext(ctx, ColumnInfoSQL, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []*ast.Column
+	for rows.Next() {
+		var (
+			name, udtName            string
+			length, precision, scale sql.NullInt64
+			nullable, identity       string
+			defaultValue, comment    string
+		)
+
+		if err = rows.Scan(
+			&name, &udtName,
+			&length, &precision, &scale,
+			&nullable, &identity,
+			&defaultValue, &comment,
+		); err != nil {
+			return nil, err
+		}
+
+		column := &ast.Column{
+			Name:       name,
+			DataType:   toDataType(udtName),
+			NotNull:    nullable != Nullable,
+			PrimaryKey: primaryKeys[name],
+			Default:    defaultValue,
+			Comment:    comment,
+		}
+
+		if identity == Identity || strings.HasPrefix(defaultValue, SequenceDefault) {
+			column.AutoIncrement = true
+			column.Default = ""
+		}
+
+		if length.Valid {
+			column.Length = toIntPtr(length.Int64)
+		}
+		if precision.Valid && column.DataType == types.DECIMAL {
+			column.Precision = toIntPtr(precision.Int64)
+		}
+		if scale.Valid && column.DataType == types.DECIMAL {
+			column.Scale = toIntPtr(scale.Int64)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, rows.Err()
+}
+
+func (r Reverser) indexes(ctx context.Context, db *sql.DB, tableName string) ([]*ast.Index, error) {
+	rows, err := db.QueryContext(ctx, IndexInfoSQL, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var indexes []*ast.Index
+	for rows.Next() {
+		var (
+			name, column string
+			unique       bool
+		)
+
+		if err = rows.Scan(&name, &unique, &column); err != nil {
+			return nil, err
+		}
+
+		// Rows are ordered by index name, column position
+		if n := len(indexes); n > 0 && indexes[n-1].Name == name {
+			indexes[n-1].Columns = append(indexes[n-1].Columns, column)
+
+			continue
+		}
+
+		indexes = append(indexes, &ast.Index{
+			Name:    name,
+			Unique:  unique,
+			Columns: []string{column},
+		})
+	}
+
+	return indexes, rows.Err()
+}
+
+// ----------------------------------------------------------------
+
+func toDataType(udtName string) string {
+	if dataType, ok := udtTypes[udtName]; ok {
+		return dataType
+	}
+
+	return udtName
+}
+
+func toIntPtr(x int64) *int {
+	v := int(x)
+
+	return &v
+}
@@ -17,30 +17,49 @@
 package postgresd
 
 const (
-	DsnTemplate = "postgresql://%s:%s@%s:%d/%s?TimeZone=%s"
+	TableInfoSQL = `SELECT table_name
+FROM information_schema.tables
+WHERE table_catalog = $1
+  AND table_schema = current_schema()
+  AND table_type = 'BASE TABLE'
+ORDER BY table_name`
 
-	TableInfoSQL = "SELECT tableName FROM pg_tables WHERE tableName NOT LIKE 'pg%' AND tableName NOT LIKE 'sql_%' ORDER BY tableName"
+	TableCommentSQL = `SELECT COALESCE(obj_description(c.oid, 'pg_class'), '')
+FROM pg_class AS c
+         INNER JOIN pg_namespace AS n ON n.oid = c.relnamespace
+WHERE n.nspname = current_schema()
+  AND c.relname = $1`
 
-	ColumnInfoSQL = `SELECT CONCAT('', cast(obj_description(relfilenode, 'pg_class') as varchar))     AS tableComment,
-       a.attname                                                                 AS namez,
-       pg_type.typname                                                           AS typname,
-       col_description(a.attrelid, a.attnum)                                     AS commentz,
-       pg_type.typlen                                                            AS typlen,
-       CONCAT('', SUBSTRING(format_type(a.atttypid, a.atttypmod) from '\(.*\)')) AS formatlength,
-       a.attnotnull                                                              AS notnullz,
-       (CASE
-            WHEN (SELECT COUNT(*)
-                  FROM pg_constraint
-                  WHERE conrelid = a.attrelid
-                    AND conkey[1] = attnum
-                    AND contype = 'p') > 0 THEN
-                TRUE
-            ELSE FALSE
-           END)                                                                  AS primarykey
-FROM pg_class AS c,
-     pg_attribute AS a
-         INNER JOIN pg_type ON pg_type.oid = a.atttypid
-WHERE c.relname = '%s'
-  AND a.attrelid = c.oid
-  AND a.attnum > 0`
+	ColumnInfoSQL = `SELECT c.column_name,
+       c.udt_name,
+       c.character_maximum_length,
+       c.numeric_precision,
+       c.numeric_scale,
+       c.is_nullable,
+       c.is_identity,
+       COALESCE(c.column_default, ''),
+       COALESCE(col_description(a.attrelid, a.attnum), '')
+FROM information_schema.columns AS c
+         INNER JOIN pg_attribute AS a
+                    ON a.attrelid = format('%I.%I', c.table_schema, c.table_name)::regclass
+                        AND a.attname = c.column_name
+WHERE c.table_schema = current_schema()
+  AND c.table_name = $1
+ORDER BY c.ordinal_position`
+
+	PrimaryKeySQL = `SELECT a.attname
+FROM pg_index AS i
+         INNER JOIN pg_attribute AS a ON a.attrelid = i.indrelid AND a.attnum = ANY (i.indkey)
+WHERE i.indrelid = format('%I.%I', current_schema(), $1)::regclass
+  AND i.indisprimary`
+
+	IndexInfoSQL = `SELECT ic.relname,
+       ix.indisunique,
+       a.attname
+FROM pg_index AS ix
+         INNER JOIN pg_class AS ic ON ic.oid = ix.indexrelid
+         INNER JOIN pg_attribute AS a ON a.attrelid = ix.indrelid AND a.attnum = ANY (ix.indkey)
+WHERE ix.indrelid = format('%I.%I', current_schema(), $1)::regclass
+  AND NOT ix.indisprimary
+ORDER BY ic.relname, array_position(ix.indkey::int2[], a.attnum)`
 )
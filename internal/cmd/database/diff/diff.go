@@ -0,0 +1,437 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/internal/cmd/database/types"
+)
+
+// ----------------------------------------------------------------
+
+type Kind string
+
+const (
+	CreateTable           Kind = "createTable"
+	DropTable             Kind = "dropTable"
+	SetTableRemarks       Kind = "setTableRemarks"
+	AddColumn             Kind = "addColumn"
+	DropColumn            Kind = "dropColumn"
+	ModifyDataType        Kind = "modifyDataType"
+	AddNotNullConstraint  Kind = "addNotNullConstraint"
+	DropNotNullConstraint Kind = "dropNotNullConstraint"
+	AddDefaultValue       Kind = "addDefaultValue"
+	DropDefaultValue      Kind = "dropDefaultValue"
+	SetColumnRemarks      Kind = "setColumnRemarks"
+	CreateIndex           Kind = "createIndex"
+	DropIndex             Kind = "dropIndex"
+	RenameTable           Kind = "renameTable"
+	RenameColumn          Kind = "renameColumn"
+)
+
+// ----------------------------------------------------------------
+
+// Change one Liquibase change turning the from schema into the to schema.
+type Change struct {
+	Kind  Kind
+	Table *ast.Table
+	// FromTable the table of the from schema, set for RenameTable.
+	FromTable *ast.Table
+	// Column the column of the to schema, or of the from schema for DropColumn.
+	Column *ast.Column
+	// From the column of the from schema, set for the column modifications.
+	From  *ast.Column
+	Index *ast.Index
+	// Restore whether the change restores an attribute of the column its modifyDataType drops,
+	// see Diff.RestoreModifiedColumns.
+	Restore bool
+}
+
+// TableDiff the ordered changes of one table.
+type TableDiff struct {
+	Name    string
+	Changes []*Change
+}
+
+// Diff the ordered changes between two schemas, grouped by table.
+//
+// Tables of the to schema come first, in their order, followed by the dropped tables.
+type Diff struct {
+	Tables []*TableDiff
+}
+
+func (d *Diff) Empty() bool {
+	return len(d.Tables) == 0
+}
+
+func (d *Diff) Changes() []*Change {
+	var changes []*Change
+	for _, table := range d.Tables {
+		changes = append(changes, table.Changes...)
+	}
+
+	return changes
+}
+
+// RestoreModifiedColumns restores the attributes of the columns the MODIFY COLUMN of MySQL
+// drops: modifyDataType drops the not null constraint, the default value and the remarks of the
+// column, add/dropNotNullConstraint its default value and remarks, setColumnRemarks its default
+// value (its columnDataType keeps the not null constraint). The changes of a column are ordered
+// so that no later change drops them again, with the default value, set by ALTER COLUMN, last.
+func (d *Diff) RestoreModifiedColumns() {
+	for _, table := range d.Tables {
+		var changes []*Change
+		for i := 0; i < len(table.Changes); i++ {
+			change := table.Changes[i]
+			if !isColumnModification(change.Kind) {
+				changes = append(changes, change)
+
+				continue
+			}
+
+			// The modifications of a column follow each other, see compareColumn.
+			j := i + 1
+			for j < len(table.Changes) && table.Changes[j].Column == change.Column {
+				if !isColumnModification(table.Changes[j].Kind) {
+					break
+				}
+				j++
+			}
+
+			changes = append(changes, restoreColumn(table.Changes[i:j])...)
+			i = j - 1
+		}
+
+		table.Changes = changes
+	}
+}
+
+// columnModifications the kinds of the modifications of a column, in the order restoreColumn
+// applies them.
+var columnModifications = []Kind{
+	ModifyDataType,
+	AddNotNullConstraint,
+	DropNotNullConstraint,
+	SetColumnRemarks,
+	AddDefaultValue,
+	DropDefaultValue,
+}
+
+func isColumnModification(kind Kind) bool {
+	for _, it := range columnModifications {
+		if it == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// restoreColumn the modifications of a column with those restoring the attributes they drop.
+func restoreColumn(changes []*Change) []*Change {
+	first := changes[0]
+	column := first.Column
+
+	kinds := make(map[Kind]*Change, len(changes))
+	for _, change := range changes {
+		kinds[change.Kind] = change
+	}
+	modified := func(candidates ...Kind) bool {
+		for _, kind := range candidates {
+			if _, ok := kinds[kind]; ok {
+				return true
+			}
+		}
+
+		return false
+	}
+	restore := func(ok bool, kind Kind, other Kind) {
+		if ok && !modified(kind, other) {
+			kinds[kind] = &Change{Kind: kind, Table: first.Table, Column: column, From: first.From, Restore: true}
+		}
+	}
+
+	restore(column.NotNull && modified(ModifyDataType), AddNotNullConstraint, DropNotNullConstraint)
+	restore(column.Comment != "" && modified(ModifyDataType, AddNotNullConstraint, DropNotNullConstraint),
+		SetColumnRemarks, SetColumnRemarks)
+	restore(column.Default != "" && !column.AutoIncrement && modified(ModifyDataType, AddNotNullConstraint, DropNotNullConstraint, SetColumnRemarks),
+		AddDefaultValue, DropDefaultValue)
+
+	var restored []*Change
+	for _, kind := range columnModifications {
+		if change, ok := kinds[kind]; ok {
+			restored = append(restored, change)
+		}
+	}
+
+	return restored
+}
+
+// ----------------------------------------------------------------
+
+// Compare computes the changes turning from into to.
+//
+// The tables and columns mapped by renames are renamed instead of being dropped and
+// created again, renames may be nil.
+func Compare(from, to *ast.Database, renames *Renames) *Diff {
+	fromTables := renamedTableMap(from, renames)
+	toTables := tableMap(to)
+
+	d := &Diff{}
+
+	for _, table := range tables(to) {
+		var changes []*Change
+		if previous, ok := fromTables[strings.ToLower(table.Name)]; ok {
+			if !strings.EqualFold(previous.Name, table.Name) {
+				changes = append(changes, &Change{Kind: RenameTable, Table: table, FromTable: previous})
+			}
+
+			changes = append(changes, compareTable(previous, table, renames)...)
+		} else {
+			changes = createTable(table)
+		}
+
+		if len(changes) > 0 {
+			d.Tables = append(d.Tables, &TableDiff{Name: table.Name, Changes: changes})
+		}
+	}
+
+	for _, table := range tables(from) {
+		if _, ok := toTables[strings.ToLower(renames.Table(table.Name))]; !ok {
+			d.Tables = append(d.Tables, &TableDiff{
+				Name:    table.Name,
+				Changes: []*Change{{Kind: DropTable, Table: table}},
+			})
+		}
+	}
+
+	return d
+}
+
+func createTable(table *ast.Table) []*Change {
+	changes := []*Change{{Kind: CreateTable, Table: table}}
+	for _, index := range table.Indexes {
+		changes = append(changes, &Change{Kind: CreateIndex, Table: table, Index: index})
+	}
+
+	return changes
+}
+
+// compareTable orders the changes of an existing table so that Liquibase can apply them
+// one by one: indexes are dropped first and created last, around the column changes.
+//
+// Every change refers to the to table, whose name is the current one once a RenameTable
+// has been applied.
+func compareTable(from, to *ast.Table, renames *Renames) []*Change {
+	var (
+		dropIndexes   []*Change
+		renameColumns []*Change
+		columns       []*Change
+		dropColumns   []*Change
+		createIndexes []*Change
+	)
+
+	if from.Comment != to.Comment {
+		columns = append(columns, &Change{Kind: SetTableRemarks, Table: to})
+	}
+
+	fromColumns := renamedColumnMap(from, renames)
+	toColumns := columnMap(to)
+
+	for _, column := range to.Columns {
+		previous, ok := fromColumns[strings.ToLower(column.Name)]
+		if !ok {
+			columns = append(columns, &Change{Kind: AddColumn, Table: to, Column: column})
+
+			continue
+		}
+
+		if !strings.EqualFold(previous.Name, column.Name) {
+			renameColumns = append(renameColumns, &Change{Kind: RenameColumn, Table: to, Column: column, From: previous})
+		}
+
+		columns = append(columns, compareColumn(to, previous, column)...)
+	}
+
+	for _, column := range from.Columns {
+		if _, ok := toColumns[strings.ToLower(renames.Column(from.Name, column.Name))]; !ok {
+			dropColumns = append(dropColumns, &Change{Kind: DropColumn, Table: to, Column: column})
+		}
+	}
+
+	fromIndexes := indexMap(from)
+	toIndexes := indexMap(to)
+
+	for _, index := range from.Indexes {
+		current, ok := toIndexes[strings.ToLower(index.Name)]
+		if !ok || !sameIndex(index, current, from.Name, renames) {
+			dropIndexes = append(dropIndexes, &Change{Kind: DropIndex, Table: to, Index: index})
+		}
+	}
+
+	for _, index := range to.Indexes {
+		previous, ok := fromIndexes[strings.ToLower(index.Name)]
+		if !ok || !sameIndex(previous, index, from.Name, renames) {
+			createIndexes = append(createIndexes, &Change{Kind: CreateIndex, Table: to, Index: index})
+		}
+	}
+
+	var changes []*Change
+	changes = append(changes, dropIndexes...)
+	changes = append(changes, renameColumns...)
+	changes = append(changes, columns...)
+	changes = append(changes, dropColumns...)
+	changes = append(changes, createIndexes...)
+
+	return changes
+}
+
+func compareColumn(table *ast.Table, from, to *ast.Column) []*Change {
+	var changes []*Change
+
+	change := func(kind Kind) {
+		changes = append(changes, &Change{Kind: kind, Table: table, Column: to, From: from})
+	}
+
+	if DataType(from) != DataType(to) {
+		change(ModifyDataType)
+	}
+
+	if !from.NotNull && to.NotNull {
+		change(AddNotNullConstraint)
+	}
+	if from.NotNull && !to.NotNull {
+		change(DropNotNullConstraint)
+	}
+
+	if from.Default != to.Default && !to.AutoIncrement {
+		if to.Default == "" {
+			change(DropDefaultValue)
+		} else {
+			change(AddDefaultValue)
+		}
+	}
+
+	if from.Comment != to.Comment {
+		change(SetColumnRemarks)
+	}
+
+	return changes
+}
+
+// ----------------------------------------------------------------
+
+// DataType the canonical data type of a column, including the length of the character
+// types and the precision and scale of the decimal type, e.g. varchar(32), decimal(16,2).
+//
+// The display width of the integer types is ignored.
+func DataType(column *ast.Column) string {
+	dataType := strings.ToLower(column.DataType)
+
+	switch dataType {
+	case types.CHAR, types.VARCHAR:
+		if column.Length != nil {
+			return fmt.Sprintf("%s(%d)", dataType, *column.Length)
+		}
+	case types.DECIMAL:
+		if column.Precision != nil {
+			scale := 0
+			if column.Scale != nil {
+				scale = *column.Scale
+			}
+
+			return fmt.Sprintf("%s(%d,%d)", dataType, *column.Precision, scale)
+		}
+	}
+
+	return dataType
+}
+
+// sameIndex reports whether the index from of the table fromTable covers the same columns
+// as the index to, once the columns are renamed.
+func sameIndex(from, to *ast.Index, fromTable string, renames *Renames) bool {
+	if from.Unique != to.Unique || len(from.Columns) != len(to.Columns) {
+		return false
+	}
+	for i := range from.Columns {
+		if !strings.EqualFold(renames.Column(fromTable, from.Columns[i]), to.Columns[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ----------------------------------------------------------------
+
+func tables(database *ast.Database) []*ast.Table {
+	if database == nil {
+		return nil
+	}
+
+	return database.Tables
+}
+
+func tableMap(database *ast.Database) map[string]*ast.Table {
+	m := make(map[string]*ast.Table)
+	for _, table := range tables(database) {
+		m[strings.ToLower(table.Name)] = table
+	}
+
+	return m
+}
+
+// renamedTableMap the tables of the from schema, keyed by their name in the to schema.
+func renamedTableMap(database *ast.Database, renames *Renames) map[string]*ast.Table {
+	m := make(map[string]*ast.Table)
+	for _, table := range tables(database) {
+		m[strings.ToLower(renames.Table(table.Name))] = table
+	}
+
+	return m
+}
+
+// renamedColumnMap the columns of a table of the from schema, keyed by their name in the to schema.
+func renamedColumnMap(table *ast.Table, renames *Renames) map[string]*ast.Column {
+	m := make(map[string]*ast.Column)
+	for _, column := range table.Columns {
+		m[strings.ToLower(renames.Column(table.Name, column.Name))] = column
+	}
+
+	return m
+}
+
+func columnMap(table *ast.Table) map[string]*ast.Column {
+	m := make(map[string]*ast.Column)
+	for _, column := range table.Columns {
+		m[strings.ToLower(column.Name)] = column
+	}
+
+	return m
+}
+
+func indexMap(table *ast.Table) map[string]*ast.Index {
+	m := make(map[string]*ast.Index)
+	for _, index := range table.Indexes {
+		m[strings.ToLower(index.Name)] = index
+	}
+
+	return m
+}
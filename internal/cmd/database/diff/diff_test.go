@@ -0,0 +1,221 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diff
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+)
+
+func intPtr(x int) *int {
+	return &x
+}
+
+func TestCompare(t *testing.T) {
+	from := &ast.Database{
+		Name: "company",
+		Tables: []*ast.Table{
+			{
+				Name:    "employee",
+				Comment: "Employee",
+				Columns: []*ast.Column{
+					{Name: "id", DataType: "bigint", NotNull: true, PrimaryKey: true, AutoIncrement: true},
+					{Name: "employee_no", DataType: "varchar", Length: intPtr(32), NotNull: true},
+					{Name: "balance", DataType: "decimal", Precision: intPtr(16), Scale: intPtr(2), Default: "0"},
+					{Name: "org_name", DataType: "varchar", Length: intPtr(64), Comment: "Org"},
+					{Name: "states", DataType: "tinyint", Length: intPtr(2), NotNull: true},
+					{Name: "remark", DataType: "text"},
+				},
+				Indexes: []*ast.Index{
+					{Name: "idx_org_name", Columns: []string{"org_name"}},
+					{Name: "uk_employee_no", Unique: true, Columns: []string{"employee_no"}},
+				},
+			},
+			{
+				Name:    "organization",
+				Columns: []*ast.Column{{Name: "id", DataType: "bigint"}},
+			},
+		},
+	}
+
+	to := &ast.Database{
+		Name: "company",
+		Tables: []*ast.Table{
+			{
+				Name:    "department",
+				Columns: []*ast.Column{{Name: "id", DataType: "bigint"}},
+				Indexes: []*ast.Index{{Name: "idx_id", Columns: []string{"id"}}},
+			},
+			{
+				Name:    "employee",
+				Comment: "Employee",
+				Columns: []*ast.Column{
+					{Name: "id", DataType: "bigint", NotNull: true, PrimaryKey: true, AutoIncrement: true},
+					{Name: "employee_no", DataType: "varchar", Length: intPtr(64), NotNull: true},
+					{Name: "balance", DataType: "decimal", Precision: intPtr(16), Scale: intPtr(2)},
+					{Name: "org_name", DataType: "varchar", Length: intPtr(64), NotNull: true, Comment: "Organization"},
+					{Name: "states", DataType: "TINYINT", Length: intPtr(4), NotNull: true, Default: "1"},
+					{Name: "email", DataType: "varchar", Length: intPtr(128)},
+				},
+				Indexes: []*ast.Index{
+					{Name: "uk_employee_no", Unique: true, Columns: []string{"employee_no", "states"}},
+				},
+			},
+		},
+	}
+
+	type change struct {
+		Kind   Kind
+		Table  string
+		Target string
+	}
+
+	want := []change{
+		{CreateTable, "department", ""},
+		{CreateIndex, "department", "idx_id"},
+		{DropIndex, "employee", "idx_org_name"},
+		{DropIndex, "employee", "uk_employee_no"},
+		{ModifyDataType, "employee", "employee_no"},
+		{DropDefaultValue, "employee", "balance"},
+		{AddNotNullConstraint, "employee", "org_name"},
+		{SetColumnRemarks, "employee", "org_name"},
+		{AddDefaultValue, "employee", "states"},
+		{AddColumn, "employee", "email"},
+		{DropColumn, "employee", "remark"},
+		{CreateIndex, "employee", "uk_employee_no"},
+		{DropTable, "organization", ""},
+	}
+
+	var got []change
+	for _, it := range Compare(from, to, nil).Changes() {
+		target := ""
+		switch {
+		case it.Index != nil:
+			target = it.Index.Name
+		case it.Column != nil:
+			target = it.Column.Name
+		}
+
+		got = append(got, change{Kind: it.Kind, Table: it.Table.Name, Target: target})
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Compare() got = %v, want %v", got, want)
+	}
+}
+
+func TestCompare_Same(t *testing.T) {
+	database := &ast.Database{
+		Tables: []*ast.Table{
+			{Name: "employee", Columns: []*ast.Column{{Name: "id", DataType: "bigint"}}},
+		},
+	}
+
+	if d := Compare(database, database, nil); !d.Empty() {
+		t.Errorf("Compare() got = %v, want empty", d.Changes())
+	}
+}
+
+func TestDiff_RestoreModifiedColumns(t *testing.T) {
+	table := func(columns ...*ast.Column) *ast.Database {
+		return &ast.Database{Tables: []*ast.Table{{Name: "employee", Columns: columns}}}
+	}
+
+	tests := []struct {
+		name string
+		from *ast.Column
+		to   *ast.Column
+		want []Kind
+	}{
+		{
+			name: "test not null commented column",
+			from: &ast.Column{Name: "name", DataType: "varchar", Length: intPtr(64), NotNull: true, Default: "''", Comment: "Name"},
+			to:   &ast.Column{Name: "name", DataType: "varchar", Length: intPtr(128), NotNull: true, Default: "''", Comment: "Name"},
+			want: []Kind{ModifyDataType, AddNotNullConstraint, SetColumnRemarks, AddDefaultValue},
+		},
+		{
+			name: "test changed remarks",
+			from: &ast.Column{Name: "name", DataType: "varchar", Length: intPtr(64), NotNull: true, Comment: "Name"},
+			to:   &ast.Column{Name: "name", DataType: "varchar", Length: intPtr(128), NotNull: true, Comment: "Full name"},
+			want: []Kind{ModifyDataType, AddNotNullConstraint, SetColumnRemarks},
+		},
+		{
+			name: "test added not null",
+			from: &ast.Column{Name: "name", DataType: "varchar", Length: intPtr(64), Default: "''", Comment: "Name"},
+			to:   &ast.Column{Name: "name", DataType: "varchar", Length: intPtr(64), NotNull: true, Default: "''", Comment: "Name"},
+			want: []Kind{AddNotNullConstraint, SetColumnRemarks, AddDefaultValue},
+		},
+		{
+			name: "test changed remarks and default",
+			from: &ast.Column{Name: "name", DataType: "varchar", Length: intPtr(64), Default: "''", Comment: "Name"},
+			to:   &ast.Column{Name: "name", DataType: "varchar", Length: intPtr(64), Default: "'-'", Comment: "Full name"},
+			want: []Kind{SetColumnRemarks, AddDefaultValue},
+		},
+		{
+			name: "test nullable column",
+			from: &ast.Column{Name: "name", DataType: "varchar", Length: intPtr(64)},
+			to:   &ast.Column{Name: "name", DataType: "varchar", Length: intPtr(128)},
+			want: []Kind{ModifyDataType},
+		},
+		{
+			name: "test changed default",
+			from: &ast.Column{Name: "name", DataType: "varchar", Length: intPtr(64), NotNull: true, Comment: "Name"},
+			to:   &ast.Column{Name: "name", DataType: "varchar", Length: intPtr(64), NotNull: true, Default: "''", Comment: "Name"},
+			want: []Kind{AddDefaultValue},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Compare(table(tt.from), table(tt.to), nil)
+			d.RestoreModifiedColumns()
+
+			var got []Kind
+			for _, change := range d.Changes() {
+				got = append(got, change.Kind)
+				if change.Restore && Classify(change).Level != Safe {
+					t.Errorf("Classify(%v) = %v, want %v", change.Kind, Classify(change).Level, Safe)
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RestoreModifiedColumns() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDataType(t *testing.T) {
+	tests := []struct {
+		name   string
+		column *ast.Column
+		want   string
+	}{
+		{name: "test varchar", column: &ast.Column{DataType: "VARCHAR", Length: intPtr(32)}, want: "varchar(32)"},
+		{name: "test decimal", column: &ast.Column{DataType: "decimal", Precision: intPtr(16), Scale: intPtr(2)}, want: "decimal(16,2)"},
+		{name: "test decimal without scale", column: &ast.Column{DataType: "decimal", Precision: intPtr(10)}, want: "decimal(10,0)"},
+		{name: "test tinyint display width", column: &ast.Column{DataType: "tinyint", Length: intPtr(2)}, want: "tinyint"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DataType(tt.column); got != tt.want {
+				t.Errorf("DataType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diff
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"gopkg.in/yaml.v3"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	// ColumnRenameThreshold the minimum score of a column rename candidate.
+	ColumnRenameThreshold = 3
+	// TableRenameThreshold the minimum percentage of matching columns of a table rename candidate.
+	TableRenameThreshold = 60
+)
+
+// ----------------------------------------------------------------
+
+// Renames maps the tables and columns of the from schema to their new names.
+//
+//	tables:
+//	  organization: org
+//	columns:
+//	  employee.org_name: organization_name
+//
+// Both keys use the names of the from schema.
+type Renames struct {
+	Tables  map[string]string `json:"tables" yaml:"tables"`
+	Columns map[string]string `json:"columns" yaml:"columns"`
+}
+
+func NewRenames() *Renames {
+	return &Renames{
+		Tables:  make(map[string]string),
+		Columns: make(map[string]string),
+	}
+}
+
+// ReadRenames reads a renames mapping file, in YAML or JSON.
+func ReadRenames(path string) (*Renames, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	renames := NewRenames()
+	if err = yaml.Unmarshal(data, renames); err != nil {
+		return nil, fmt.Errorf("read renames %s failed: %w", path, err)
+	}
+
+	return renames.normalize(), nil
+}
+
+// Table the name of a table of the from schema in the to schema.
+func (r *Renames) Table(table string) string {
+	if r != nil {
+		if name, ok := r.Tables[strings.ToLower(table)]; ok {
+			return name
+		}
+	}
+
+	return table
+}
+
+// Column the name of a column of a table of the from schema in the to schema.
+func (r *Renames) Column(table, column string) string {
+	if r != nil {
+		if name, ok := r.Columns[columnKey(table, column)]; ok {
+			return name
+		}
+	}
+
+	return column
+}
+
+func (r *Renames) AddTable(from, to string) {
+	r.Tables[strings.ToLower(from)] = to
+}
+
+func (r *Renames) AddColumn(table, from, to string) {
+	r.Columns[columnKey(table, from)] = to
+}
+
+func (r *Renames) Add(candidate *Candidate) {
+	switch candidate.Kind {
+	case RenameTable:
+		r.AddTable(candidate.From, candidate.To)
+	case RenameColumn:
+		r.AddColumn(candidate.Table, candidate.From, candidate.To)
+	}
+}
+
+func (r *Renames) normalize() *Renames {
+	tables := make(map[string]string, len(r.Tables))
+	for from, to := range r.Tables {
+		tables[strings.ToLower(from)] = to
+	}
+
+	columns := make(map[string]string, len(r.Columns))
+	for from, to := range r.Columns {
+		columns[strings.ToLower(from)] = to
+	}
+
+	r.Tables, r.Columns = tables, columns
+
+	return r
+}
+
+func columnKey(table, column string) string {
+	return strings.ToLower(table + "." + column)
+}
+
+// ----------------------------------------------------------------
+
+// Candidate a likely rename, a dropped table or column matching a created one.
+type Candidate struct {
+	Kind Kind
+	// Table the from schema name of the table holding the renamed column.
+	Table string
+	From  string
+	To    string
+	Score int
+	// Reasons what the two tables or columns have in common.
+	Reasons []string
+}
+
+func (c *Candidate) String() string {
+	if c.Kind == RenameTable {
+		return fmt.Sprintf("%s -> %s(%s)", c.From, c.To, strings.Join(c.Reasons, ", "))
+	}
+
+	return fmt.Sprintf("%s.%s -> %s.%s(%s)", c.Table, c.From, c.Table, c.To, strings.Join(c.Reasons, ", "))
+}
+
+// Candidates detects the likely renames between from and to, not already mapped by renames.
+//
+// A dropped table is a rename candidate of a created table when most of their columns
+// match by name and data type. A dropped column is a rename candidate of a column added
+// to the same table when they share the data type and enough of the position, comment,
+// nullability, default value and key constraints.
+//
+// Each table or column appears in one candidate at most, the best scored one.
+func Candidates(from, to *ast.Database, renames *Renames) []*Candidate {
+	candidates := tableCandidates(from, to, renames)
+
+	accepted := NewRenames()
+	if renames != nil {
+		for k, v := range renames.Tables {
+			accepted.Tables[k] = v
+		}
+		for k, v := range renames.Columns {
+			accepted.Columns[k] = v
+		}
+	}
+	for _, candidate := range candidates {
+		accepted.Add(candidate)
+	}
+
+	toTables := tableMap(to)
+	for _, table := range tables(from) {
+		current, ok := toTables[strings.ToLower(accepted.Table(table.Name))]
+		if !ok {
+			continue
+		}
+
+		candidates = append(candidates, columnCandidates(table, current, accepted)...)
+	}
+
+	return candidates
+}
+
+func tableCandidates(from, to *ast.Database, renames *Renames) []*Candidate {
+	fromTables := renamedTableMap(from, renames)
+	toTables := tableMap(to)
+
+	var dropped, created []*ast.Table
+	for _, table := range tables(from) {
+		if _, ok := toTables[strings.ToLower(renames.Table(table.Name))]; !ok {
+			dropped = append(dropped, table)
+		}
+	}
+	for _, table := range tables(to) {
+		if _, ok := fromTables[strings.ToLower(table.Name)]; !ok {
+			created = append(created, table)
+		}
+	}
+
+	var candidates []*Candidate
+	for _, f := range dropped {
+		for _, t := range created {
+			if candidate := scoreTable(f, t); candidate.Score >= TableRenameThreshold {
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+
+	return pick(candidates)
+}
+
+func columnCandidates(from, to *ast.Table, renames *Renames) []*Candidate {
+	fromColumns := renamedColumnMap(from, renames)
+	toColumns := columnMap(to)
+
+	var candidates []*Candidate
+	for i, f := range from.Columns {
+		if _, ok := toColumns[strings.ToLower(renames.Column(from.Name, f.Name))]; ok {
+			continue
+		}
+
+		for j, t := range to.Columns {
+			if _, ok := fromColumns[strings.ToLower(t.Name)]; ok {
+				continue
+			}
+
+			candidate := scoreColumn(f, t, i == j)
+			candidate.Table = from.Name
+			if candidate.Score >= ColumnRenameThreshold {
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+
+	return pick(candidates)
+}
+
+func scoreTable(from, to *ast.Table) *Candidate {
+	candidate := &Candidate{Kind: RenameTable, From: from.Name, To: to.Name}
+
+	toColumns := columnMap(to)
+
+	matched := 0
+	for _, column := range from.Columns {
+		if current, ok := toColumns[strings.ToLower(column.Name)]; ok && DataType(current) == DataType(column) {
+			matched++
+		}
+	}
+
+	total := len(from.Columns)
+	if len(to.Columns) > total {
+		total = len(to.Columns)
+	}
+	if total > 0 {
+		candidate.Score = matched * 100 / total
+	}
+	candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("%d/%d columns", matched, total))
+
+	if from.Comment != "" && from.Comment == to.Comment {
+		candidate.Score += 10
+		candidate.Reasons = append(candidate.Reasons, "comment")
+	}
+
+	return candidate
+}
+
+// scoreColumn the score of renaming the column from to, its type the same or of the same
+// family, e.g. varchar(32) widened to varchar(64), the columns of unrelated types scoring none.
+func scoreColumn(from, to *ast.Column, samePosition bool) *Candidate {
+	candidate := &Candidate{Kind: RenameColumn, From: from.Name, To: to.Name}
+
+	sameType := DataType(from) == DataType(to)
+	fromFamily := family(strings.ToLower(from.DataType))
+	sameFamily := fromFamily != "" && fromFamily == family(strings.ToLower(to.DataType))
+	if !sameType && !sameFamily {
+		return candidate
+	}
+
+	match := func(ok bool, score int, reason string) {
+		if ok {
+			candidate.Score += score
+			candidate.Reasons = append(candidate.Reasons, reason)
+		}
+	}
+
+	match(sameType, 2, "type")
+	match(!sameType, 1, "type family")
+	match(samePosition, 2, "position")
+	match(from.Comment != "" && from.Comment == to.Comment, 2, "comment")
+	match(from.NotNull == to.NotNull, 1, "nullable")
+	match(from.Default == to.Default, 1, "default")
+	match(from.PrimaryKey == to.PrimaryKey && from.Unique == to.Unique && from.AutoIncrement == to.AutoIncrement,
+		1, "constraints")
+
+	return candidate
+}
+
+// pick keeps the best scored candidate of every table or column, in a stable order.
+func pick(candidates []*Candidate) []*Candidate {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+
+	fromUsed := make(map[string]bool)
+	toUsed := make(map[string]bool)
+
+	var picked []*Candidate
+	for _, candidate := range candidates {
+		if fromUsed[candidate.From] || toUsed[candidate.To] {
+			continue
+		}
+
+		fromUsed[candidate.From] = true
+		toUsed[candidate.To] = true
+		picked = append(picked, candidate)
+	}
+
+	return picked
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diff
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+)
+
+func renameDatabases() (*ast.Database, *ast.Database) {
+	from := &ast.Database{
+		Tables: []*ast.Table{
+			{
+				Name:    "organization",
+				Comment: "Organization",
+				Columns: []*ast.Column{
+					{Name: "id", DataType: "bigint", NotNull: true, PrimaryKey: true},
+					{Name: "org_no", DataType: "varchar", Length: intPtr(32), NotNull: true},
+					{Name: "org_name", DataType: "varchar", Length: intPtr(64), Comment: "Name"},
+				},
+				Indexes: []*ast.Index{{Name: "idx_org_name", Columns: []string{"org_name"}}},
+			},
+		},
+	}
+
+	to := &ast.Database{
+		Tables: []*ast.Table{
+			{
+				Name:    "org",
+				Comment: "Organization",
+				Columns: []*ast.Column{
+					{Name: "id", DataType: "bigint", NotNull: true, PrimaryKey: true},
+					{Name: "org_no", DataType: "varchar", Length: intPtr(32), NotNull: true},
+					{Name: "name", DataType: "varchar", Length: intPtr(64), Comment: "Name"},
+				},
+				Indexes: []*ast.Index{{Name: "idx_org_name", Columns: []string{"name"}}},
+			},
+		},
+	}
+
+	return from, to
+}
+
+func TestCandidates(t *testing.T) {
+	from, to := renameDatabases()
+
+	var got []string
+	for _, candidate := range Candidates(from, to, nil) {
+		got = append(got, string(candidate.Kind)+" "+candidate.Table+" "+candidate.From+" "+candidate.To)
+	}
+
+	want := []string{
+		"renameTable  organization org",
+		"renameColumn organization org_name name",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Candidates() got = %v, want %v", got, want)
+	}
+}
+
+func TestCandidates_DataType(t *testing.T) {
+	from := &ast.Database{
+		Tables: []*ast.Table{
+			{Name: "employee", Columns: []*ast.Column{{Name: "org_name", DataType: "varchar", Length: intPtr(64)}}},
+		},
+	}
+	to := &ast.Database{
+		Tables: []*ast.Table{
+			{Name: "employee", Columns: []*ast.Column{{Name: "org_id", DataType: "bigint"}}},
+		},
+	}
+
+	if got := Candidates(from, to, nil); len(got) != 0 {
+		t.Errorf("Candidates() got = %v, want none", got)
+	}
+}
+
+func TestCandidates_Widen(t *testing.T) {
+	from := &ast.Database{
+		Tables: []*ast.Table{
+			{Name: "employee", Columns: []*ast.Column{
+				{Name: "id", DataType: "bigint", NotNull: true, PrimaryKey: true},
+				{Name: "org_name", DataType: "varchar", Length: intPtr(32), Comment: "Organization"},
+			}},
+		},
+	}
+	to := &ast.Database{
+		Tables: []*ast.Table{
+			{Name: "employee", Columns: []*ast.Column{
+				{Name: "id", DataType: "bigint", NotNull: true, PrimaryKey: true},
+				{Name: "organization_name", DataType: "varchar", Length: intPtr(64), Comment: "Organization"},
+			}},
+		},
+	}
+
+	candidates := Candidates(from, to, nil)
+	if len(candidates) != 1 || candidates[0].Kind != RenameColumn ||
+		candidates[0].From != "org_name" || candidates[0].To != "organization_name" {
+		t.Fatalf("Candidates() got = %v, want renameColumn org_name organization_name", candidates)
+	}
+	if want := []string{"type family", "position", "comment", "nullable", "default", "constraints"}; !reflect.DeepEqual(candidates[0].Reasons, want) {
+		t.Errorf("Candidates() got reasons = %v, want %v", candidates[0].Reasons, want)
+	}
+
+	renames := NewRenames()
+	renames.AddColumn("employee", "org_name", "organization_name")
+
+	var got []Kind
+	for _, change := range Compare(from, to, renames).Changes() {
+		got = append(got, change.Kind)
+	}
+	if want := []Kind{RenameColumn, ModifyDataType}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Compare() got = %v, want %v", got, want)
+	}
+}
+
+func TestCompare_Renames(t *testing.T) {
+	from, to := renameDatabases()
+
+	renames := NewRenames()
+	renames.AddTable("organization", "org")
+	renames.AddColumn("organization", "org_name", "name")
+
+	var got []Kind
+	for _, change := range Compare(from, to, renames).Changes() {
+		got = append(got, change.Kind)
+	}
+
+	want := []Kind{RenameTable, RenameColumn}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Compare() got = %v, want %v", got, want)
+	}
+
+	if got := Compare(from, to, nil).Changes(); got[0].Kind != CreateTable {
+		t.Errorf("Compare() got = %v, want %v", got[0].Kind, CreateTable)
+	}
+}
+
+func TestReadRenames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "renames.yaml")
+	content := `tables:
+  Organization: org
+columns:
+  organization.ORG_NAME: name
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	renames, err := ReadRenames(path)
+	if err != nil {
+		t.Fatalf("ReadRenames() error = %v", err)
+	}
+
+	if got := renames.Table("organization"); got != "org" {
+		t.Errorf("Renames.Table() got = %v, want org", got)
+	}
+	if got := renames.Column("organization", "org_name"); got != "name" {
+		t.Errorf("Renames.Column() got = %v, want name", got)
+	}
+	if got := renames.Column("organization", "org_no"); got != "org_no" {
+		t.Errorf("Renames.Column() got = %v, want org_no", got)
+	}
+
+	var nilRenames *Renames
+	if got := nilRenames.Table("employee"); got != "employee" {
+		t.Errorf("Renames.Table() got = %v, want employee", got)
+	}
+}
@@ -0,0 +1,201 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/photowey/liquigen/internal/cmd/database/types"
+)
+
+// ----------------------------------------------------------------
+
+// Level the risk level of a change, ordered from the safest.
+type Level int
+
+const (
+	// Safe the change neither loses data nor fails on existing data.
+	Safe Level = iota
+	// Blocking the change may fail on existing data, rewrite or lock the table, or break
+	// the running clients; it needs a review before being applied.
+	Blocking
+	// Destructive the change loses data.
+	Destructive
+)
+
+var levelNames = map[Level]string{
+	Safe:        "safe",
+	Blocking:    "blocking",
+	Destructive: "destructive",
+}
+
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+// ParseLevel parses the name of a level: safe, blocking or destructive.
+func ParseLevel(name string) (Level, error) {
+	for level, it := range levelNames {
+		if strings.EqualFold(it, name) {
+			return level, nil
+		}
+	}
+
+	return Safe, fmt.Errorf("unknown risk level %q, want safe, blocking or destructive", name)
+}
+
+// ----------------------------------------------------------------
+
+// Risk the risk of one change, and why.
+type Risk struct {
+	Change *Change
+	Level  Level
+	Reason string
+}
+
+// Analyze classifies every change of d, in order.
+func Analyze(d *Diff) []*Risk {
+	var risks []*Risk
+	for _, change := range d.Changes() {
+		risks = append(risks, Classify(change))
+	}
+
+	return risks
+}
+
+// MaxLevel the highest level of risks, Safe when empty.
+func MaxLevel(risks []*Risk) Level {
+	level := Safe
+	for _, risk := range risks {
+		if risk.Level > level {
+			level = risk.Level
+		}
+	}
+
+	return level
+}
+
+// Classify the risk of one change.
+func Classify(change *Change) *Risk {
+	risk := func(level Level, reason string) *Risk {
+		return &Risk{Change: change, Level: level, Reason: reason}
+	}
+
+	if change.Restore {
+		return risk(Safe, "restores the attribute dropped by the modifyDataType of the column")
+	}
+
+	switch change.Kind {
+	case DropTable:
+		return risk(Destructive, "drops the table and its data")
+	case DropColumn:
+		return risk(Destructive, "drops the column and its data")
+	case ModifyDataType:
+		return classifyDataType(change, risk)
+	case AddNotNullConstraint:
+		if change.Column.Default == "" {
+			return risk(Blocking, "fails on existing null values, the column has no default value")
+		}
+
+		return risk(Blocking, "fails on existing null values")
+	case AddColumn:
+		if change.Column.NotNull && change.Column.Default == "" && !change.Column.AutoIncrement {
+			return risk(Blocking, "adds a not null column without default value, fails on a non-empty table")
+		}
+	case CreateIndex:
+		if change.Index.Unique {
+			return risk(Blocking, "fails on existing duplicated values")
+		}
+	case RenameTable:
+		return risk(Blocking, "breaks the clients using the previous table name")
+	case RenameColumn:
+		return risk(Blocking, "breaks the clients using the previous column name")
+	}
+
+	return risk(Safe, "")
+}
+
+func classifyDataType(change *Change, risk func(Level, string) *Risk) *Risk {
+	from, to := change.From, change.Column
+	fromType, toType := strings.ToLower(from.DataType), strings.ToLower(to.DataType)
+	conversion := fmt.Sprintf("%s to %s", DataType(from), DataType(to))
+
+	if fromType != toType && (family(fromType) == "" || family(fromType) != family(toType)) {
+		return risk(Destructive, "converts "+conversion)
+	}
+	if rank(toType) < rank(fromType) {
+		return risk(Destructive, "narrows "+conversion)
+	}
+
+	switch {
+	case isCharacter(fromType) && isCharacter(toType) && value(to.Length) < value(from.Length):
+		return risk(Destructive, "narrows "+conversion+", truncates the longer values")
+	case fromType == types.DECIMAL && value(to.Scale) != value(from.Scale):
+		return risk(Destructive, "changes the scale of "+conversion+", rounds the values")
+	case fromType == types.DECIMAL && value(to.Precision) < value(from.Precision):
+		return risk(Destructive, "narrows "+conversion+", overflows the larger values")
+	}
+
+	return risk(Blocking, "widens "+conversion+", rewrites the table")
+}
+
+// ----------------------------------------------------------------
+
+// families the data types convertible into each other without losing the values of the
+// narrower ones, ordered from the narrowest.
+var families = map[string][]string{
+	"integer":   {types.TINYINT, types.SMALLINT, types.MEDIUMINT, types.INT, types.BIGINT},
+	"character": {types.CHAR, types.VARCHAR, "tinytext", types.TEXT, "mediumtext", "longtext"},
+	"float":     {types.FLOAT, types.DOUBLE},
+	"decimal":   {types.DECIMAL},
+}
+
+func family(dataType string) string {
+	for name, members := range families {
+		for _, member := range members {
+			if member == dataType {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
+
+// rank the position of a data type in its family, -1 outside any family.
+func rank(dataType string) int {
+	for i, member := range families[family(dataType)] {
+		if member == dataType {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isCharacter(dataType string) bool {
+	return dataType == types.CHAR || dataType == types.VARCHAR
+}
+
+func value(x *int) int {
+	if x == nil {
+		return 0
+	}
+
+	return *x
+}
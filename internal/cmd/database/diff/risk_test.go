@@ -0,0 +1,116 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+)
+
+func TestClassify(t *testing.T) {
+	table := &ast.Table{Name: "employee"}
+	modify := func(from, to *ast.Column) *Change {
+		return &Change{Kind: ModifyDataType, Table: table, From: from, Column: to}
+	}
+
+	tests := []struct {
+		name   string
+		change *Change
+		want   Level
+	}{
+		{
+			name:   "test drop column",
+			change: &Change{Kind: DropColumn, Table: table, Column: &ast.Column{Name: "remark"}},
+			want:   Destructive,
+		},
+		{
+			name:   "test drop table",
+			change: &Change{Kind: DropTable, Table: table},
+			want:   Destructive,
+		},
+		{
+			name: "test narrow varchar",
+			change: modify(&ast.Column{DataType: "varchar", Length: intPtr(64)},
+				&ast.Column{DataType: "varchar", Length: intPtr(32)}),
+			want: Destructive,
+		},
+		{
+			name: "test widen varchar",
+			change: modify(&ast.Column{DataType: "varchar", Length: intPtr(32)},
+				&ast.Column{DataType: "varchar", Length: intPtr(64)}),
+			want: Blocking,
+		},
+		{
+			name: "test decimal scale",
+			change: modify(&ast.Column{DataType: "decimal", Precision: intPtr(16), Scale: intPtr(4)},
+				&ast.Column{DataType: "decimal", Precision: intPtr(18), Scale: intPtr(2)}),
+			want: Destructive,
+		},
+		{
+			name:   "test narrow integer",
+			change: modify(&ast.Column{DataType: "bigint"}, &ast.Column{DataType: "int"}),
+			want:   Destructive,
+		},
+		{
+			name:   "test widen integer",
+			change: modify(&ast.Column{DataType: "int"}, &ast.Column{DataType: "bigint"}),
+			want:   Blocking,
+		},
+		{
+			name:   "test convert",
+			change: modify(&ast.Column{DataType: "varchar", Length: intPtr(32)}, &ast.Column{DataType: "bigint"}),
+			want:   Destructive,
+		},
+		{
+			name:   "test add not null",
+			change: &Change{Kind: AddNotNullConstraint, Table: table, Column: &ast.Column{Name: "org_id", NotNull: true}},
+			want:   Blocking,
+		},
+		{
+			name:   "test add not null column without default",
+			change: &Change{Kind: AddColumn, Table: table, Column: &ast.Column{Name: "org_id", NotNull: true}},
+			want:   Blocking,
+		},
+		{
+			name:   "test add not null column with default",
+			change: &Change{Kind: AddColumn, Table: table, Column: &ast.Column{Name: "org_id", NotNull: true, Default: "0"}},
+			want:   Safe,
+		},
+		{
+			name:   "test create table",
+			change: &Change{Kind: CreateTable, Table: table},
+			want:   Safe,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Classify(tt.change); got.Level != tt.want {
+				t.Errorf("Classify() = %v(%s), want %v", got.Level, got.Reason, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	if got, err := ParseLevel("Destructive"); err != nil || got != Destructive {
+		t.Errorf("ParseLevel() = %v, %v, want %v", got, err, Destructive)
+	}
+	if _, err := ParseLevel("fatal"); err == nil {
+		t.Errorf("ParseLevel() want error")
+	}
+}
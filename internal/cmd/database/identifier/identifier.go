@@ -0,0 +1,151 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package identifier
+
+import (
+	"strings"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	MySQL    = "mysql"
+	Postgres = "postgres"
+	SQLite   = "sqlite"
+	Oracle   = "oracle"
+)
+
+// _aliases the other names of the dialects, e.g. the dbms names of Liquibase.
+var _aliases = map[string]string{
+	"postgresql": Postgres,
+	"mariadb":    MySQL,
+}
+
+// _profiles the identifier rules of the dialects.
+var _profiles = map[string]*Profile{
+	MySQL:    {Dialect: MySQL, MaxLength: 64, reserved: words(_mysqlReservedWords)},
+	Postgres: {Dialect: Postgres, MaxLength: 63, reserved: words(_postgresReservedWords)},
+	SQLite:   {Dialect: SQLite, reserved: words(_sqliteReservedWords)},
+	Oracle:   {Dialect: Oracle, MaxLength: 30, reserved: words(_oracleReservedWords)},
+}
+
+// ----------------------------------------------------------------
+
+// Profile the identifier rules of a dialect: its reserved words, and the maximum length of
+// its identifiers in bytes.
+type Profile struct {
+	Dialect string
+	// MaxLength the maximum length in bytes, 0 when unlimited.
+	MaxLength int
+	reserved  map[string]struct{}
+}
+
+// ProfileOf the profile of the dialect, e.g. mysql or postgresql.
+func ProfileOf(dialect string) (*Profile, bool) {
+	dialect = strings.ToLower(strings.TrimSpace(dialect))
+	if alias, ok := _aliases[dialect]; ok {
+		dialect = alias
+	}
+
+	profile, ok := _profiles[dialect]
+
+	return profile, ok
+}
+
+// Dialects the dialects with a profile, sorted.
+func Dialects() []string {
+	return []string{MySQL, Oracle, Postgres, SQLite}
+}
+
+// Reserved reports whether the name is a reserved word of the dialect, whatever its case.
+func (p *Profile) Reserved(name string) bool {
+	_, ok := p.reserved[strings.ToUpper(name)]
+
+	return ok
+}
+
+// TooLong reports whether the name exceeds the maximum length of the dialect.
+func (p *Profile) TooLong(name string) bool {
+	return p.MaxLength > 0 && len(name) > p.MaxLength
+}
+
+// ----------------------------------------------------------------
+
+// Configured the source of the rules configured in liquigen.json, reported in place of a dialect.
+const Configured = "liquigen.json"
+
+// Rules the identifier rules of the target dialects, and those configured on top of them.
+type Rules struct {
+	Profiles []*Profile
+	// MaxLength the configured maximum length in bytes, 0 for the shortest one of Profiles.
+	MaxLength int
+	// ReservedWords the names reserved on top of those of Profiles, e.g. type.
+	ReservedWords []string
+}
+
+// Limit the configured maximum length, else the shortest one of the profiles, and its source:
+// Configured or the dialect; 0 when unlimited.
+func (r *Rules) Limit() (int, string) {
+	if r.MaxLength > 0 {
+		return r.MaxLength, Configured
+	}
+
+	limit, dialect := 0, ""
+	for _, profile := range r.Profiles {
+		if profile.MaxLength > 0 && (limit == 0 || profile.MaxLength < limit) {
+			limit, dialect = profile.MaxLength, profile.Dialect
+		}
+	}
+
+	return limit, dialect
+}
+
+// ReservedBy the sources reserving the name: Configured for the reserved words, then the
+// dialects of the profiles.
+func (r *Rules) ReservedBy(name string) []string {
+	var reserved []string
+	for _, word := range r.ReservedWords {
+		if strings.EqualFold(word, name) {
+			reserved = append(reserved, Configured)
+
+			break
+		}
+	}
+	for _, profile := range r.Profiles {
+		if profile.Reserved(name) {
+			reserved = append(reserved, profile.Dialect)
+		}
+	}
+
+	return reserved
+}
+
+// ----------------------------------------------------------------
+
+// Unquote the name without its quotes, e.g. the backquotes of MySQL.
+func Unquote(name string) string {
+	return strings.Trim(name, "`\"[]")
+}
+
+func words(values string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, word := range strings.Fields(values) {
+		set[word] = struct{}{}
+	}
+
+	return set
+}
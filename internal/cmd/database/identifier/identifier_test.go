@@ -0,0 +1,104 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package identifier
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProfile(t *testing.T) {
+	tests := []struct {
+		dialect      string
+		name         string
+		wantReserved bool
+		wantTooLong  bool
+	}{
+		{dialect: "mysql", name: "order", wantReserved: true},
+		{dialect: "mysql", name: "user"},
+		{dialect: "postgresql", name: "User", wantReserved: true},
+		{dialect: "oracle", name: "comment", wantReserved: true},
+		{dialect: "oracle", name: "a_column_name_longer_than_thirty_bytes", wantTooLong: true},
+		{dialect: "postgres", name: "a_column_name_longer_than_thirty_bytes"},
+		{dialect: "sqlite", name: "employee"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect+"/"+tt.name, func(t *testing.T) {
+			profile, ok := ProfileOf(tt.dialect)
+			if !ok {
+				t.Fatalf("ProfileOf() got no profile of %s", tt.dialect)
+			}
+			if got := profile.Reserved(tt.name); got != tt.wantReserved {
+				t.Errorf("Reserved() got = %v, want %v", got, tt.wantReserved)
+			}
+			if got := profile.TooLong(tt.name); got != tt.wantTooLong {
+				t.Errorf("TooLong() got = %v, want %v", got, tt.wantTooLong)
+			}
+		})
+	}
+}
+
+func TestUnquote(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "`order`", want: "order"},
+		{name: `"user"`, want: "user"},
+		{name: "[desc]", want: "desc"},
+		{name: "employee", want: "employee"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unquote(tt.name); got != tt.want {
+				t.Errorf("Unquote() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRules(t *testing.T) {
+	mysql, _ := ProfileOf(MySQL)
+	oracle, _ := ProfileOf(Oracle)
+
+	tests := []struct {
+		name         string
+		rules        *Rules
+		word         string
+		wantReserved []string
+		wantLimit    int
+		wantLimitOf  string
+	}{
+		{name: "shortest limit", rules: &Rules{Profiles: []*Profile{mysql, oracle}}, word: "comment",
+			wantReserved: []string{Oracle}, wantLimit: 30, wantLimitOf: Oracle},
+		{name: "configured", rules: &Rules{Profiles: []*Profile{mysql}, MaxLength: 128, ReservedWords: []string{"type", "TYPE"}}, word: "Type",
+			wantReserved: []string{Configured}, wantLimit: 128, wantLimitOf: Configured},
+		{name: "configured and dialect", rules: &Rules{Profiles: []*Profile{mysql}, ReservedWords: []string{"order"}}, word: "order",
+			wantReserved: []string{Configured, MySQL}, wantLimit: 64, wantLimitOf: MySQL},
+		{name: "unlimited", rules: &Rules{}, word: "employee"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rules.ReservedBy(tt.word); !reflect.DeepEqual(got, tt.wantReserved) {
+				t.Errorf("ReservedBy() got = %v, want %v", got, tt.wantReserved)
+			}
+			if limit, limitOf := tt.rules.Limit(); limit != tt.wantLimit || limitOf != tt.wantLimitOf {
+				t.Errorf("Limit() got = %d, %s, want %d, %s", limit, limitOf, tt.wantLimit, tt.wantLimitOf)
+			}
+		})
+	}
+}
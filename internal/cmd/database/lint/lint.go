@@ -0,0 +1,111 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ----------------------------------------------------------------
+
+// Severity the severity of a problem, ordered from the mildest.
+type Severity int
+
+const (
+	// Warning the problem is a hazard worth a review, e.g. an includeAll ordering hazard.
+	Warning Severity = iota
+	// Error the problem breaks, or silently skips, the deployment, e.g. a duplicate changeSet.
+	Error
+)
+
+var severityNames = map[Severity]string{
+	Warning: "warning",
+	Error:   "error",
+}
+
+func (s Severity) String() string {
+	return severityNames[s]
+}
+
+// MarshalText the name of the severity, e.g. in the JSON reports.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// ParseSeverity parses the name of a severity: warning or error.
+func ParseSeverity(name string) (Severity, error) {
+	for severity, it := range severityNames {
+		if strings.EqualFold(it, name) {
+			return severity, nil
+		}
+	}
+
+	return Warning, fmt.Errorf("unknown severity %q, want warning or error", name)
+}
+
+// ----------------------------------------------------------------
+
+// Problem one problem reported by a rule.
+type Problem struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	File     string   `json:"file,omitempty"`
+	// Line the line in File, 0 when unknown.
+	Line int `json:"line,omitempty"`
+	// Object the schema object, e.g. employee.org_name, for the problems of a schema.
+	Object  string `json:"object,omitempty"`
+	Message string `json:"message"`
+}
+
+func (p *Problem) String() string {
+	location := p.File
+	if p.Line > 0 {
+		location = fmt.Sprintf("%s:%d", p.File, p.Line)
+	}
+	if location == "" {
+		location = p.Object
+	}
+	if location == "" {
+		return fmt.Sprintf("%s: %s", p.Rule, p.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", location, p.Rule, p.Message)
+}
+
+// Failed reports whether one of problems is at least as severe as failOn.
+func Failed(problems []*Problem, failOn Severity) bool {
+	for _, problem := range problems {
+		if problem.Severity >= failOn {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Count the number of problems of the severity.
+func Count(problems []*Problem, severity Severity) int {
+	count := 0
+	for _, problem := range problems {
+		if problem.Severity == severity {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lint
+
+import (
+	"testing"
+)
+
+func TestParseSeverity(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Severity
+		wantErr bool
+	}{
+		{name: "warning", want: Warning},
+		{name: "ERROR", want: Error},
+		{name: "fatal", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSeverity(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSeverity() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseSeverity() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFailed(t *testing.T) {
+	problems := []*Problem{{Rule: "include-all-order", Severity: Warning}}
+
+	if Failed(problems, Error) {
+		t.Errorf("Failed() got = true on %v, want false", Error)
+	}
+	if !Failed(problems, Warning) {
+		t.Errorf("Failed() got = false on %v, want true", Warning)
+	}
+}
+
+func TestProblem_String(t *testing.T) {
+	tests := []struct {
+		name    string
+		problem *Problem
+		want    string
+	}{
+		{name: "line", problem: &Problem{Rule: "missing-author", File: "master.xml", Line: 12, Message: "changeSet 001 without author"},
+			want: "master.xml:12: missing-author: changeSet 001 without author"},
+		{name: "file", problem: &Problem{Rule: "include-missing", File: "master.xml", Message: "not found"},
+			want: "master.xml: include-missing: not found"},
+		{name: "object", problem: &Problem{Rule: "primary-key", Object: "employee", Message: "table without primary key"},
+			want: "employee: primary-key: table without primary key"},
+		{name: "none", problem: &Problem{Rule: "primary-key", Message: "table employee without primary key"},
+			want: "primary-key: table employee without primary key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.problem.String(); got != tt.want {
+				t.Errorf("String() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/internal/cmd/database/identifier"
+	"github.com/photowey/liquigen/internal/cmd/database/types"
+	"github.com/photowey/liquigen/pkg/alphabet"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	RuleTableComment     = "table-comment"
+	RuleColumnComment    = "column-comment"
+	RulePrimaryKey       = "primary-key"
+	RuleSnakeCase        = "snake-case"
+	RuleReservedWord     = "reserved-word"
+	RuleIdentifierLength = "identifier-length"
+	RuleVarcharLength    = "varchar-length"
+	RuleAuditColumns     = "audit-columns"
+
+	// Off the severity of a disabled rule, in liquigen.json.
+	Off = "off"
+
+	DefaultVarcharLength = 4000
+	PrimaryIndex         = "PRIMARY"
+)
+
+var (
+	_schemaRules = []string{
+		RuleTableComment, RuleColumnComment, RulePrimaryKey, RuleSnakeCase,
+		RuleReservedWord, RuleIdentifierLength, RuleVarcharLength, RuleAuditColumns,
+	}
+	_defaultAuditColumns = []string{"create_by", "create_time", "update_by", "update_time"}
+	_snakeCaseRegexp     = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+)
+
+// ----------------------------------------------------------------
+
+// SchemaRules the rules a schema is checked against, and their settings.
+type SchemaRules struct {
+	// Severities the severity of the enabled rules, the others are off.
+	Severities map[string]Severity
+	// Dialects the target dialects of the reserved-word and identifier-length rules.
+	Dialects []string
+	// IdentifierLength the maximum length of the names in bytes, 0 for the shortest
+	// limit of Dialects.
+	IdentifierLength int
+	// VarcharLength the maximum length of a varchar column.
+	VarcharLength int
+	// AuditColumns the columns every table has, e.g. create_time.
+	AuditColumns []string
+	// ReservedWords the names reserved on top of those of Dialects, e.g. type.
+	ReservedWords []string
+}
+
+func DefaultSchemaRules() *SchemaRules {
+	return &SchemaRules{
+		Severities: map[string]Severity{
+			RuleTableComment:     Error,
+			RuleColumnComment:    Warning,
+			RulePrimaryKey:       Error,
+			RuleSnakeCase:        Error,
+			RuleReservedWord:     Error,
+			RuleIdentifierLength: Error,
+			RuleVarcharLength:    Warning,
+			RuleAuditColumns:     Warning,
+		},
+		VarcharLength: DefaultVarcharLength,
+		AuditColumns:  append([]string(nil), _defaultAuditColumns...),
+	}
+}
+
+// SchemaRuleNames the names of the schema rules.
+func SchemaRuleNames() []string {
+	return append([]string(nil), _schemaRules...)
+}
+
+// SetSeverity enables the rule with the severity, warning or error, or disables it, off.
+func (r *SchemaRules) SetSeverity(rule, severity string) error {
+	known := false
+	for _, it := range _schemaRules {
+		known = known || it == rule
+	}
+	if !known {
+		return fmt.Errorf("unknown schema rule %q, want one of %s", rule, strings.Join(_schemaRules, ", "))
+	}
+
+	if strings.EqualFold(severity, Off) {
+		delete(r.Severities, rule)
+
+		return nil
+	}
+
+	parsed, err := ParseSeverity(severity)
+	if err != nil {
+		return fmt.Errorf("schema rule %s: %w", rule, err)
+	}
+	r.Severities[rule] = parsed
+
+	return nil
+}
+
+// ----------------------------------------------------------------
+
+// Schema checks the tables of the database against the rules, in order.
+func Schema(database *ast.Database, rules *SchemaRules) ([]*Problem, error) {
+	s := &schemaLinter{rules: rules, identifiers: &identifier.Rules{
+		MaxLength:     rules.IdentifierLength,
+		ReservedWords: rules.ReservedWords,
+	}}
+	for _, dialect := range rules.Dialects {
+		profile, ok := identifier.ProfileOf(dialect)
+		if !ok {
+			return nil, fmt.Errorf("no identifier rules for the dialect %s, want one of %s",
+				dialect, strings.Join(identifier.Dialects(), ", "))
+		}
+		s.identifiers.Profiles = append(s.identifiers.Profiles, profile)
+	}
+
+	for _, table := range database.Tables {
+		s.table(table)
+	}
+
+	return s.problems, nil
+}
+
+type schemaLinter struct {
+	rules       *SchemaRules
+	identifiers *identifier.Rules
+	problems    []*Problem
+}
+
+func (s *schemaLinter) table(table *ast.Table) {
+	tableName := identifier.Unquote(table.Name)
+	s.name(tableName, "table", tableName)
+	if strings.TrimSpace(table.Comment) == "" {
+		s.report(RuleTableComment, tableName, "table without comment")
+	}
+
+	primaryKey, columns := false, make(map[string]bool, len(table.Columns))
+	for _, column := range table.Columns {
+		columnName := identifier.Unquote(column.Name)
+		object := tableName + "." + columnName
+		primaryKey = primaryKey || column.PrimaryKey
+		columns[strings.ToLower(columnName)] = true
+
+		s.name(columnName, "column", object)
+		if strings.TrimSpace(column.Comment) == "" {
+			s.report(RuleColumnComment, object, "column without comment")
+		}
+		if strings.EqualFold(column.DataType, types.VARCHAR) && column.Length != nil && *column.Length > s.rules.VarcharLength {
+			s.report(RuleVarcharLength, object,
+				fmt.Sprintf("varchar(%d) longer than %d, use a text column", *column.Length, s.rules.VarcharLength))
+		}
+	}
+
+	for _, index := range table.Indexes {
+		if strings.EqualFold(index.Name, PrimaryIndex) {
+			primaryKey = true
+
+			continue
+		}
+		indexName := identifier.Unquote(index.Name)
+		s.name(indexName, "index", tableName+"."+indexName)
+	}
+
+	if !primaryKey {
+		s.report(RulePrimaryKey, tableName, "table without primary key")
+	}
+
+	var missing []string
+	for _, column := range s.rules.AuditColumns {
+		if !columns[strings.ToLower(column)] {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) > 0 {
+		s.report(RuleAuditColumns, tableName, fmt.Sprintf("table without the audit columns %s", strings.Join(missing, ", ")))
+	}
+}
+
+// name checks the name of a table, a column or an index.
+func (s *schemaLinter) name(name, kind, object string) {
+	if name == "" {
+		return
+	}
+
+	if alphabet.SnakeCase(name) != name || !_snakeCaseRegexp.MatchString(name) {
+		s.report(RuleSnakeCase, object, fmt.Sprintf("%s name %s is not snake_case, e.g. %s", kind, name, alphabet.SnakeCase(name)))
+	}
+
+	if reserved := s.identifiers.ReservedBy(name); len(reserved) > 0 {
+		s.report(RuleReservedWord, object, fmt.Sprintf("%s name %s is reserved by %s", kind, name, strings.Join(reserved, ", ")))
+	}
+
+	if limit, dialect := s.identifiers.Limit(); limit > 0 && len(name) > limit {
+		s.report(RuleIdentifierLength, object, fmt.Sprintf("%s name %s is %d bytes long, over the %d of %s",
+			kind, name, len(name), limit, dialect))
+	}
+}
+
+func (s *schemaLinter) report(rule, object, message string) {
+	severity, ok := s.rules.Severities[rule]
+	if !ok {
+		return
+	}
+
+	s.problems = append(s.problems, &Problem{
+		Rule:     rule,
+		Severity: severity,
+		Object:   object,
+		Message:  message,
+	})
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+)
+
+func TestSchema(t *testing.T) {
+	length := func(n int) *int { return &n }
+
+	database := &ast.Database{Tables: []*ast.Table{
+		{Name: "employee", Comment: "Employee", Columns: []*ast.Column{
+			{Name: "id", DataType: "bigint", PrimaryKey: true, Comment: "ID"},
+			{Name: "create_by", DataType: "bigint", Comment: "Creator"},
+			{Name: "create_time", DataType: "timestamp", Comment: "Created"},
+			{Name: "update_by", DataType: "bigint", Comment: "Updater"},
+			{Name: "update_time", DataType: "timestamp", Comment: "Updated"},
+		}},
+		{Name: "`Order`", Columns: []*ast.Column{
+			{Name: "orderNo", DataType: "varchar", Length: length(8000)},
+			{Name: "user", DataType: "bigint", Comment: "User"},
+			{Name: "a_column_name_longer_than_thirty_bytes", DataType: "int", Comment: "Long"},
+		}, Indexes: []*ast.Index{{Name: "idxUser", Columns: []string{"user"}}}},
+	}}
+
+	tests := []struct {
+		name      string
+		configure func(rules *SchemaRules)
+		want      []string
+	}{
+		{
+			name:      "default",
+			configure: func(rules *SchemaRules) { rules.Dialects = []string{"mysql", "postgresql", "oracle"} },
+			want: []string{
+				"error snake-case Order: table name Order is not snake_case, e.g. order",
+				"error reserved-word Order: table name Order is reserved by mysql, postgres, oracle",
+				"error table-comment Order: table without comment",
+				"error snake-case Order.orderNo: column name orderNo is not snake_case, e.g. order_no",
+				"warning column-comment Order.orderNo: column without comment",
+				"warning varchar-length Order.orderNo: varchar(8000) longer than 4000, use a text column",
+				"error reserved-word Order.user: column name user is reserved by postgres, oracle",
+				"error identifier-length Order.a_column_name_longer_than_thirty_bytes: " +
+					"column name a_column_name_longer_than_thirty_bytes is 38 bytes long, over the 30 of oracle",
+				"error snake-case Order.idxUser: index name idxUser is not snake_case, e.g. idx_user",
+				"error primary-key Order: table without primary key",
+				"warning audit-columns Order: table without the audit columns create_by, create_time, update_by, update_time",
+			},
+		},
+		{
+			name: "configured",
+			configure: func(rules *SchemaRules) {
+				for _, rule := range []string{RuleSnakeCase, RuleTableComment, RuleColumnComment, RulePrimaryKey, RuleAuditColumns} {
+					_ = rules.SetSeverity(rule, Off)
+				}
+				_ = rules.SetSeverity(RuleVarcharLength, "error")
+				rules.IdentifierLength = 40
+				rules.VarcharLength = 10000
+				rules.ReservedWords = []string{"employee"}
+			},
+			want: []string{
+				"error reserved-word employee: table name employee is reserved by liquigen.json",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := DefaultSchemaRules()
+			tt.configure(rules)
+
+			problems, err := Schema(database, rules)
+			if err != nil {
+				t.Fatalf("Schema() error = %v", err)
+			}
+
+			var got []string
+			for _, problem := range problems {
+				got = append(got, problem.Severity.String()+" "+problem.Rule+" "+problem.Object+": "+problem.Message)
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Schema() got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestSchema_unknownDialect(t *testing.T) {
+	rules := DefaultSchemaRules()
+	rules.Dialects = []string{"db2"}
+
+	if _, err := Schema(&ast.Database{}, rules); err == nil {
+		t.Errorf("Schema() error = nil, want an unknown dialect error")
+	}
+}
+
+func TestSchemaRules_SetSeverity(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     string
+		severity string
+		want     Severity
+		enabled  bool
+		wantErr  bool
+	}{
+		{name: "error", rule: RuleColumnComment, severity: "error", want: Error, enabled: true},
+		{name: "off", rule: RulePrimaryKey, severity: "OFF"},
+		{name: "unknown rule", rule: "camel-case", severity: "error", wantErr: true},
+		{name: "unknown severity", rule: RulePrimaryKey, severity: "fatal", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := DefaultSchemaRules()
+			if err := rules.SetSeverity(tt.rule, tt.severity); (err != nil) != tt.wantErr {
+				t.Fatalf("SetSeverity() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got, enabled := rules.Severities[tt.rule]
+			if enabled != tt.enabled || got != tt.want {
+				t.Errorf("SetSeverity() got = %v(%v), want %v(%v)", got, enabled, tt.want, tt.enabled)
+			}
+		})
+	}
+}
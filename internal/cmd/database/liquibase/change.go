@@ -0,0 +1,542 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package liquibase
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/internal/cmd/database/types"
+)
+
+// ----------------------------------------------------------------
+
+// _ignored the changeSet children describing no schema change.
+var _ignored = map[string]struct{}{
+	"comment":       {},
+	"preConditions": {},
+	"rollback":      {},
+	"validCheckSum": {},
+	"modifySql":     {},
+	"tagDatabase":   {},
+	"empty":         {},
+	"output":        {},
+}
+
+// errColumnPosition the afterColumn or beforeColumn of an added column names a missing column.
+var errColumnPosition = errors.New("column position not found")
+
+var _defaultValueAttrs = []string{
+	"defaultValue",
+	"defaultValueNumeric",
+	"defaultValueBoolean",
+	"defaultValueDate",
+	"defaultValueComputed",
+	"defaultValueSequenceNext",
+}
+
+// ----------------------------------------------------------------
+
+// apply replays one change on the rebuilt schema, an error reports an unsupported change.
+func (i *importer) apply(change *node) error {
+	attr := func(name string) string {
+		return i.expand(change.Attrs[name])
+	}
+
+	switch change.Name {
+	case "createTable":
+		return i.createTable(change)
+	case "dropTable":
+		return i.dropTable(attr("tableName"))
+	case "renameTable":
+		return i.withTable(attr("oldTableName"), func(table *ast.Table) error {
+			table.Name = attr("newTableName")
+
+			return nil
+		})
+	case "setTableRemarks":
+		return i.withTable(attr("tableName"), func(table *ast.Table) error {
+			table.Comment = attr("remarks")
+
+			return nil
+		})
+	case "addColumn":
+		return i.withTable(attr("tableName"), func(table *ast.Table) error {
+			return i.addColumns(table, change.Children)
+		})
+	case "dropColumn":
+		return i.withTable(attr("tableName"), func(table *ast.Table) error {
+			return i.dropColumns(table, change)
+		})
+	case "renameColumn":
+		return i.withColumn(attr("tableName"), attr("oldColumnName"), func(table *ast.Table, column *ast.Column) error {
+			renameIndexColumns(table, column.Name, attr("newColumnName"))
+			column.Name = attr("newColumnName")
+
+			return nil
+		})
+	case "modifyDataType":
+		return i.withColumn(attr("tableName"), attr("columnName"), func(_ *ast.Table, column *ast.Column) error {
+			return populateDataType(column, attr("newDataType"))
+		})
+	case "addNotNullConstraint", "dropNotNullConstraint":
+		return i.withColumn(attr("tableName"), attr("columnName"), func(_ *ast.Table, column *ast.Column) error {
+			column.NotNull = change.Name == "addNotNullConstraint"
+
+			return nil
+		})
+	case "addDefaultValue":
+		return i.withColumn(attr("tableName"), attr("columnName"), func(_ *ast.Table, column *ast.Column) error {
+			column.Default, column.UpdateTimestamp = i.defaultValue(change)
+
+			return nil
+		})
+	case "dropDefaultValue":
+		return i.withColumn(attr("tableName"), attr("columnName"), func(_ *ast.Table, column *ast.Column) error {
+			column.Default, column.UpdateTimestamp = "", false
+
+			return nil
+		})
+	case "setColumnRemarks":
+		return i.withColumn(attr("tableName"), attr("columnName"), func(_ *ast.Table, column *ast.Column) error {
+			column.Comment = attr("remarks")
+
+			return nil
+		})
+	case "addAutoIncrement":
+		return i.withColumn(attr("tableName"), attr("columnName"), func(_ *ast.Table, column *ast.Column) error {
+			column.AutoIncrement = true
+
+			return nil
+		})
+	case "addPrimaryKey", "dropPrimaryKey":
+		return i.primaryKey(change)
+	case "createIndex":
+		return i.withTable(attr("tableName"), func(table *ast.Table) error {
+			return i.createIndex(table, attr("indexName"), attr("unique") == "true", columnNames(change, i.expand))
+		})
+	case "addUniqueConstraint":
+		return i.withTable(attr("tableName"), func(table *ast.Table) error {
+			return i.createIndex(table, attr("constraintName"), true, splitNames(attr("columnNames")))
+		})
+	case "dropIndex":
+		return i.withTable(attr("tableName"), func(table *ast.Table) error {
+			return dropIndex(table, attr("indexName"))
+		})
+	case "dropUniqueConstraint":
+		return i.withTable(attr("tableName"), func(table *ast.Table) error {
+			return dropIndex(table, attr("constraintName"))
+		})
+	case "addForeignKeyConstraint":
+		return i.withTable(attr("baseTableName"), func(table *ast.Table) error {
+			for _, name := range splitNames(attr("baseColumnNames")) {
+				if column := findColumn(table, name); column != nil {
+					column.ForeignKey = true
+				}
+			}
+
+			return nil
+		})
+	default:
+		return errors.New("not modelled by liquigen")
+	}
+}
+
+// ----------------------------------------------------------------
+
+func (i *importer) createTable(change *node) error {
+	name := i.expand(change.Attrs["tableName"])
+	if findTable(i.result.Database, name) != nil {
+		return fmt.Errorf("table %s already exists", name)
+	}
+
+	table := &ast.Table{
+		Name:    name,
+		Comment: i.expand(change.Attrs["remarks"]),
+	}
+	err := i.addColumns(table, change.Children)
+	if err != nil && !errors.Is(err, errColumnPosition) {
+		return err
+	}
+
+	i.result.Database.Tables = append(i.result.Database.Tables, table)
+
+	return err
+}
+
+func (i *importer) dropTable(name string) error {
+	tables := i.result.Database.Tables
+	for idx, table := range tables {
+		if strings.EqualFold(table.Name, name) {
+			i.result.Database.Tables = append(tables[:idx:idx], tables[idx+1:]...)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("table %s not found", name)
+}
+
+// addColumns adds the columns to the table, at the position of their afterColumn or
+// beforeColumn; those whose position names a missing column are added last, and reported
+// by an errColumnPosition once they are all added.
+func (i *importer) addColumns(table *ast.Table, children []*node) error {
+	var misplaced []string
+	for _, child := range children {
+		if child.Name != "column" {
+			continue
+		}
+
+		column, err := i.column(child)
+		if err != nil {
+			return err
+		}
+		if findColumn(table, column.Name) != nil {
+			return fmt.Errorf("column %s.%s already exists", table.Name, column.Name)
+		}
+
+		position := len(table.Columns)
+		for _, attr := range []string{"afterColumn", "beforeColumn"} {
+			name := i.expand(child.Attrs[attr])
+			if name == "" {
+				continue
+			}
+
+			idx := columnIndex(table, name)
+			if idx < 0 {
+				misplaced = append(misplaced, fmt.Sprintf("%s %s of %s", attr, name, column.Name))
+
+				continue
+			}
+			if attr == "afterColumn" {
+				idx++
+			}
+			position = idx
+		}
+
+		table.Columns = append(table.Columns[:position],
+			append([]*ast.Column{column}, table.Columns[position:]...)...)
+	}
+
+	if len(misplaced) > 0 {
+		return fmt.Errorf("%w: %s of table %s, added last", errColumnPosition, strings.Join(misplaced, ", "), table.Name)
+	}
+
+	return nil
+}
+
+func (i *importer) dropColumns(table *ast.Table, change *node) error {
+	names := columnNames(change, i.expand)
+	if name := i.expand(change.Attrs["columnName"]); name != "" {
+		names = append(names, name)
+	}
+
+	for _, name := range names {
+		idx := columnIndex(table, name)
+		if idx < 0 {
+			return fmt.Errorf("column %s.%s not found", table.Name, name)
+		}
+
+		table.Columns = append(table.Columns[:idx], table.Columns[idx+1:]...)
+		i.dropIndexColumn(table, name)
+	}
+
+	return nil
+}
+
+// dropIndexColumn drops the column from the indexes of the table as the database does:
+// Postgres drops the indexes of the column, the others drop the column from its indexes,
+// and the indexes left without a column.
+func (i *importer) dropIndexColumn(table *ast.Table, name string) {
+	postgres := strings.EqualFold(i.dialect, "postgres") || strings.EqualFold(i.dialect, "postgresql")
+
+	var indexes []*ast.Index
+	for _, index := range table.Indexes {
+		var columns []string
+		for _, column := range index.Columns {
+			if !strings.EqualFold(column, name) {
+				columns = append(columns, column)
+			}
+		}
+
+		if len(columns) < len(index.Columns) {
+			if postgres || len(columns) == 0 {
+				continue
+			}
+			index.Columns = columns
+		}
+
+		indexes = append(indexes, index)
+	}
+
+	table.Indexes = indexes
+}
+
+func (i *importer) column(n *node) (*ast.Column, error) {
+	column := &ast.Column{
+		Name:          i.expand(n.Attrs["name"]),
+		Comment:       i.expand(n.Attrs["remarks"]),
+		AutoIncrement: n.Attrs["autoIncrement"] == "true",
+	}
+	if err := populateDataType(column, i.expand(n.Attrs["type"])); err != nil {
+		return nil, err
+	}
+
+	column.Default, column.UpdateTimestamp = i.defaultValue(n)
+
+	for _, child := range n.Children {
+		if child.Name != "constraints" {
+			continue
+		}
+
+		if child.Attrs["primaryKey"] == "true" {
+			column.PrimaryKey, column.NotNull = true, true
+		}
+		if child.Attrs["nullable"] == "false" {
+			column.NotNull = true
+		}
+		if child.Attrs["unique"] == "true" {
+			column.Unique = true
+		}
+		if child.Attrs["references"] != "" || child.Attrs["referencedTableName"] != "" {
+			column.ForeignKey = true
+		}
+	}
+
+	return column, nil
+}
+
+// defaultValue the default value of a column or of addDefaultValue, and whether it updates
+// on update, e.g. defaultValueComputed="CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP".
+func (i *importer) defaultValue(n *node) (string, bool) {
+	for _, name := range _defaultValueAttrs {
+		value, ok := n.Attrs[name]
+		if !ok {
+			continue
+		}
+
+		value = i.expand(value)
+		if idx := strings.Index(strings.ToUpper(value), " ON UPDATE "); idx >= 0 {
+			return strings.TrimSpace(value[:idx]), true
+		}
+
+		return value, false
+	}
+
+	return "", false
+}
+
+func (i *importer) primaryKey(change *node) error {
+	return i.withTable(i.expand(change.Attrs["tableName"]), func(table *ast.Table) error {
+		if change.Name == "dropPrimaryKey" {
+			for _, column := range table.Columns {
+				column.PrimaryKey = false
+			}
+
+			return nil
+		}
+
+		for _, name := range splitNames(i.expand(change.Attrs["columnNames"])) {
+			column := findColumn(table, name)
+			if column == nil {
+				return fmt.Errorf("column %s.%s not found", table.Name, name)
+			}
+
+			column.PrimaryKey, column.NotNull = true, true
+		}
+
+		return nil
+	})
+}
+
+func (i *importer) createIndex(table *ast.Table, name string, unique bool, columns []string) error {
+	if name == "" {
+		return errors.New("index without name")
+	}
+	if findIndex(table, name) >= 0 {
+		return fmt.Errorf("index %s.%s already exists", table.Name, name)
+	}
+
+	table.Indexes = append(table.Indexes, &ast.Index{Name: name, Unique: unique, Columns: columns})
+
+	return nil
+}
+
+func (i *importer) withTable(name string, fn func(table *ast.Table) error) error {
+	table := findTable(i.result.Database, name)
+	if table == nil {
+		return fmt.Errorf("table %s not found", name)
+	}
+
+	return fn(table)
+}
+
+func (i *importer) withColumn(tableName, name string, fn func(table *ast.Table, column *ast.Column) error) error {
+	return i.withTable(tableName, func(table *ast.Table) error {
+		column := findColumn(table, name)
+		if column == nil {
+			return fmt.Errorf("column %s.%s not found", tableName, name)
+		}
+
+		return fn(table, column)
+	})
+}
+
+// ----------------------------------------------------------------
+
+// populateDataType parses a Liquibase column type, e.g. VARCHAR(32), decimal(16, 2) or
+// BIGINT UNSIGNED, into the data type, length, precision and scale of column.
+func populateDataType(column *ast.Column, dataType string) error {
+	dataType = strings.ToLower(strings.TrimSpace(dataType))
+	dataType = strings.TrimPrefix(dataType, "java.sql.types.")
+	if dataType == "" {
+		return fmt.Errorf("column %s without type", column.Name)
+	}
+
+	column.Unsigned = strings.Contains(dataType, "unsigned")
+	column.Zerofill = strings.Contains(dataType, "zerofill")
+	column.Length, column.Precision, column.Scale = nil, nil, nil
+
+	name, params := dataType, ""
+	if start := strings.Index(dataType, "("); start >= 0 {
+		end := strings.Index(dataType, ")")
+		if end < start {
+			return fmt.Errorf("column %s has a malformed type %s", column.Name, dataType)
+		}
+
+		name, params = dataType[:start], dataType[start+1:end]
+	} else if fields := strings.Fields(dataType); len(fields) > 0 {
+		name = fields[0]
+	}
+
+	name = strings.TrimSpace(name)
+	switch name {
+	case "integer":
+		name = types.INT
+	case "numeric", "number":
+		name = types.DECIMAL
+	}
+	column.DataType = name
+
+	var values []*int
+	for _, param := range splitNames(params) {
+		value, err := strconv.Atoi(param)
+		if err != nil {
+			return fmt.Errorf("column %s has a malformed type %s", column.Name, dataType)
+		}
+		values = append(values, &value)
+	}
+
+	switch {
+	case name == types.DECIMAL && len(values) > 0:
+		column.Precision = values[0]
+		if len(values) > 1 {
+			column.Scale = values[1]
+		}
+	case len(values) > 0:
+		column.Length = values[0]
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------
+
+func findTable(database *ast.Database, name string) *ast.Table {
+	for _, table := range database.Tables {
+		if strings.EqualFold(table.Name, name) {
+			return table
+		}
+	}
+
+	return nil
+}
+
+func findColumn(table *ast.Table, name string) *ast.Column {
+	if idx := columnIndex(table, name); idx >= 0 {
+		return table.Columns[idx]
+	}
+
+	return nil
+}
+
+func columnIndex(table *ast.Table, name string) int {
+	for idx, column := range table.Columns {
+		if strings.EqualFold(column.Name, name) {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+func findIndex(table *ast.Table, name string) int {
+	for idx, index := range table.Indexes {
+		if strings.EqualFold(index.Name, name) {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+func dropIndex(table *ast.Table, name string) error {
+	idx := findIndex(table, name)
+	if idx < 0 {
+		return fmt.Errorf("index %s.%s not found", table.Name, name)
+	}
+
+	table.Indexes = append(table.Indexes[:idx], table.Indexes[idx+1:]...)
+
+	return nil
+}
+
+func renameIndexColumns(table *ast.Table, from, to string) {
+	for _, index := range table.Indexes {
+		for idx, column := range index.Columns {
+			if strings.EqualFold(column, from) {
+				index.Columns[idx] = to
+			}
+		}
+	}
+}
+
+// columnNames the names of the nested column elements of a change.
+func columnNames(change *node, expand func(string) string) []string {
+	var names []string
+	for _, child := range change.Children {
+		if child.Name == "column" {
+			names = append(names, expand(child.Attrs["name"]))
+		}
+	}
+
+	return names
+}
+
+func splitNames(names string) []string {
+	var values []string
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			values = append(values, name)
+		}
+	}
+
+	return values
+}
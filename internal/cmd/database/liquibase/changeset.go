@@ -0,0 +1,163 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package liquibase
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// ErrNotChangelog the document read is not a changelog, e.g. a pom.xml.
+var ErrNotChangelog = errors.New("liquibase: not a changelog")
+
+// ----------------------------------------------------------------
+
+// _changeSetAttributes the changeSet children which are not changes.
+var _changeSetAttributes = map[string]struct{}{
+	"comment":       {},
+	"preConditions": {},
+	"rollback":      {},
+	"validCheckSum": {},
+	"modifySql":     {},
+}
+
+// ----------------------------------------------------------------
+
+// ChangeSet one changeSet of a changelog, as written.
+type ChangeSet struct {
+	ID      string
+	Author  string
+	Dbms    string
+	Context string
+	Labels  string
+	Comment string
+	// Changes the changes of the changeSet, e.g. createTable, in order.
+	Changes []*Element
+	// ModifySQL the modifySql elements of the changeSet, in order.
+	ModifySQL []*Element
+}
+
+// Element one element of a changeSet.
+type Element struct {
+	Name  string
+	Attrs map[string]string
+	// Text the character data of the element, e.g. the SQL of a sql change.
+	Text     string
+	Children []*Element
+}
+
+// ReadChangeSets reads the changeSets of one changelog document, the included changelogs
+// are not followed.
+func ReadChangeSets(r io.Reader) ([]*ChangeSet, error) {
+	root, err := parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("liquibase: parse changelog failed: %w", err)
+	}
+	if root.Name != "databaseChangeLog" {
+		return nil, fmt.Errorf("%w, root element %s", ErrNotChangelog, root.Name)
+	}
+
+	var changeSets []*ChangeSet
+	for _, child := range root.Children {
+		if child.Name == "changeSet" {
+			changeSets = append(changeSets, newChangeSet(child))
+		}
+	}
+
+	return changeSets, nil
+}
+
+func newChangeSet(n *node) *ChangeSet {
+	changeSet := &ChangeSet{
+		ID:      n.Attrs["id"],
+		Author:  n.Attrs["author"],
+		Dbms:    n.Attrs["dbms"],
+		Context: n.Attrs["context"],
+		Labels:  n.Attrs["labels"],
+	}
+	if changeSet.Context == "" {
+		changeSet.Context = n.Attrs["contextFilter"]
+	}
+
+	for _, child := range n.Children {
+		switch child.Name {
+		case "comment":
+			changeSet.Comment = strings.TrimSpace(child.Text)
+		case "modifySql":
+			changeSet.ModifySQL = append(changeSet.ModifySQL, newElement(child))
+		}
+		if _, ok := _changeSetAttributes[child.Name]; !ok {
+			changeSet.Changes = append(changeSet.Changes, newElement(child))
+		}
+	}
+
+	return changeSet
+}
+
+func newElement(n *node) *Element {
+	element := &Element{Name: n.Name, Attrs: n.Attrs, Text: n.Text}
+	for _, child := range n.Children {
+		element.Children = append(element.Children, newElement(child))
+	}
+
+	return element
+}
+
+// ----------------------------------------------------------------
+
+// Description the description Liquibase records for the changeSet, e.g.
+// "createTable tableName=employee; createIndex indexName=idx_name, tableName=employee".
+func (c *ChangeSet) Description() string {
+	if len(c.Changes) == 0 {
+		return "empty"
+	}
+
+	descriptions := make([]string, 0, len(c.Changes))
+	for _, change := range c.Changes {
+		descriptions = append(descriptions, change.Description())
+	}
+
+	return LimitSize(strings.Join(descriptions, "; "), 255)
+}
+
+// Description the description of the change: its name and its *Name attributes, but the
+// schema and catalog ones, e.g. "addColumn tableName=employee".
+func (e *Element) Description() string {
+	var names []string
+	for key, value := range e.Attrs {
+		lower := strings.ToLower(key)
+		if strings.HasSuffix(lower, "name") && !strings.Contains(lower, "schema") && !strings.Contains(lower, "catalog") {
+			names = append(names, key+"="+value)
+		}
+	}
+	sort.Strings(names)
+
+	return e.Name + " " + LimitSize(strings.Join(names, ", "), 255)
+}
+
+// LimitSize value cut to size characters, ending with "..." when cut.
+func LimitSize(value string, size int) string {
+	runes := []rune(value)
+	if len(runes) <= size {
+		return value
+	}
+
+	return string(runes[:size-3]) + "..."
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package liquibase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestReadChangeSets(t *testing.T) {
+	changelog := header + `
+    <changeSet id="employee_001" author="dev" dbms="mysql" context="dev,test" labels="v1.0.0">
+        <preConditions onFail="MARK_RAN">
+            <not><tableExists tableName="employee"/></not>
+        </preConditions>
+        <comment> Initialize the table: employee </comment>
+        <createTable tableName="employee" remarks="Employee">
+            <column name="id" type="${type.bigint}"/>
+        </createTable>
+        <createIndex tableName="employee" indexName="idx_name" schemaName="hr">
+            <column name="name"/>
+        </createIndex>
+        <modifySql dbms="mysql"><append value=" ENGINE=InnoDB"/></modifySql>
+    </changeSet>
+    <changeSet id="employee_002" author="dev" contextFilter="prod">
+        <renameColumn tableName="employee" oldColumnName="org" newColumnName="org_name"/>
+    </changeSet>
+    <changeSet id="employee_003" author="dev"/>
+` + footer
+
+	changeSets, err := ReadChangeSets(strings.NewReader(changelog))
+	if err != nil {
+		t.Fatalf("ReadChangeSets() error = %v", err)
+	}
+	if len(changeSets) != 3 {
+		t.Fatalf("ReadChangeSets() got %d changeSets, want 3", len(changeSets))
+	}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "id", got: changeSets[0].ID, want: "employee_001"},
+		{name: "context", got: changeSets[0].Context, want: "dev,test"},
+		{name: "context filter", got: changeSets[1].Context, want: "prod"},
+		{name: "comment", got: changeSets[0].Comment, want: "Initialize the table: employee"},
+		{name: "description", got: changeSets[0].Description(),
+			want: "createTable tableName=employee; createIndex indexName=idx_name, tableName=employee"},
+		{name: "rename description", got: changeSets[1].Description(),
+			want: "renameColumn newColumnName=org_name, oldColumnName=org, tableName=employee"},
+		{name: "empty description", got: changeSets[2].Description(), want: "empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadChangeSets_notChangelog(t *testing.T) {
+	_, err := ReadChangeSets(strings.NewReader(`<project><modelVersion>4.0.0</modelVersion></project>`))
+	if !errors.Is(err, ErrNotChangelog) {
+		t.Errorf("ReadChangeSets() error = %v, want ErrNotChangelog", err)
+	}
+}
+
+func TestLimitSize(t *testing.T) {
+	if got := LimitSize("createTable", 20); got != "createTable" {
+		t.Errorf("LimitSize() got = %q, want createTable", got)
+	}
+	if got := LimitSize("createTable tableName=employee", 14); got != "createTable..." {
+		t.Errorf("LimitSize() got = %q, want createTable...", got)
+	}
+}
@@ -0,0 +1,329 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package liquibase
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ----------------------------------------------------------------
+
+// ChecksumVersion the version of the checksum algorithm of Liquibase, the prefix of the
+// MD5SUM recorded in DATABASECHANGELOG, e.g. "9:".
+type ChecksumVersion int
+
+const (
+	// ChecksumV8 the checksums of Liquibase 3.5 to 4.23.
+	ChecksumV8 ChecksumVersion = 8
+	// ChecksumV9 the checksums of Liquibase 4.24 and later.
+	ChecksumV9 ChecksumVersion = 9
+
+	ChecksumIndent = 4
+)
+
+// ErrUnsupportedChecksum the changeSet holds a change, or a modifySql, whose checksum
+// liquigen can't compute.
+var ErrUnsupportedChecksum = errors.New("liquibase: checksum not supported")
+
+// _checksumChanges the changes whose checksum is computed: those liquigen generates,
+// true for those holding a collection of columns.
+var _checksumChanges = map[string]bool{
+	"createTable":           true,
+	"dropTable":             false,
+	"setTableRemarks":       false,
+	"addColumn":             true,
+	"dropColumn":            true,
+	"modifyDataType":        false,
+	"addNotNullConstraint":  false,
+	"dropNotNullConstraint": false,
+	"addDefaultValue":       false,
+	"dropDefaultValue":      false,
+	"setColumnRemarks":      false,
+	"createIndex":           true,
+	"dropIndex":             false,
+	"renameTable":           false,
+	"renameColumn":          false,
+}
+
+// _checksumVisitors the modifySql visitors whose checksum is computed.
+var _checksumVisitors = map[string]struct{}{
+	"replace":       {},
+	"regExpReplace": {},
+	"append":        {},
+	"prepend":       {},
+}
+
+// _booleanFields the Boolean fields, serialized as Boolean.valueOf does.
+var _booleanFields = map[string]struct{}{
+	"autoIncrement":      {},
+	"cascadeConstraints": {},
+	"clustered":          {},
+	"computed":           {},
+	"deferrable":         {},
+	"deleteCascade":      {},
+	"descending":         {},
+	"initiallyDeferred":  {},
+	"nullable":           {},
+	"primaryKey":         {},
+	"unique":             {},
+	"validateForeignKey": {},
+	"validateNullable":   {},
+	"validatePrimaryKey": {},
+	"validateUnique":     {},
+}
+
+// ----------------------------------------------------------------
+
+// ParseChecksumVersion parses the version of the checksum algorithm, 8 or 9.
+func ParseChecksumVersion(value string) (ChecksumVersion, error) {
+	version, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(value), "v"))
+	if err != nil || (ChecksumVersion(version) != ChecksumV8 && ChecksumVersion(version) != ChecksumV9) {
+		return 0, fmt.Errorf("unknown checksum version %q, want 8 or 9", value)
+	}
+
+	return ChecksumVersion(version), nil
+}
+
+// ChecksumVersionOf the checksum version of the Liquibase release, e.g. 8 for "4.9.1",
+// 9 for "4.24.0" and the releases it can't parse.
+func ChecksumVersionOf(release string) ChecksumVersion {
+	parts := strings.SplitN(strings.TrimSpace(release), ".", 3)
+	if len(parts) < 2 {
+		return ChecksumV9
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return ChecksumV9
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return ChecksumV9
+	}
+	if major < 4 || (major == 4 && minor < 24) {
+		return ChecksumV8
+	}
+
+	return ChecksumV9
+}
+
+// ----------------------------------------------------------------
+
+// Checksum the MD5SUM Liquibase records for the changeSet, e.g. "9:2f6a...", its ${name}
+// placeholders expanded with properties, as Liquibase does when parsing the changelog.
+//
+// As in Liquibase, it is the checksum of the checksums of the changes, each followed by
+// ":", then of the modifySql visitors, each followed by ";". The checksum of a change is
+// the MD5 of its StringChangeLogSerializer form:
+//
+//	createTable:[
+//	    columns=[
+//	        column:[
+//	            name="id"
+//	        ]
+//	    ]
+//	    tableName="employee"
+//	]
+//
+// The comment, preconditions and rollback are not part of it. Only the changes liquigen
+// generates are supported, the others, e.g. sql, fail with ErrUnsupportedChecksum.
+//
+// The version 9 differs here from the version 8 only by the prefix of the checksums of the
+// changes, hashed into that of the changeSet; the other differences of Liquibase 4.24, if
+// any, show as a mismatch with the checksums testdata/checksum/record.sh records.
+func (c *ChangeSet) Checksum(version ChecksumVersion, properties map[string]string) (string, error) {
+	s := &serializer{properties: properties}
+
+	var buf strings.Builder
+	for _, change := range c.Changes {
+		serialized, err := s.change(change)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s", ErrUnsupportedChecksum, err)
+		}
+
+		buf.WriteString(computeChecksum(version, serialized) + ":")
+	}
+
+	for _, modifySQL := range c.ModifySQL {
+		for _, visitor := range modifySQL.Children {
+			serialized, err := s.visitor(modifySQL, visitor)
+			if err != nil {
+				return "", fmt.Errorf("%w: %s", ErrUnsupportedChecksum, err)
+			}
+
+			buf.WriteString(computeChecksum(version, serialized) + ";")
+		}
+	}
+
+	return computeChecksum(version, buf.String()), nil
+}
+
+// computeChecksum the CheckSum.compute of Liquibase: the MD5 of the value, its line endings
+// standardized, the U+FFFD characters removed and NFC normalized, prefixed with the version.
+func computeChecksum(version ChecksumVersion, value string) string {
+	value = strings.ReplaceAll(strings.ReplaceAll(value, "\r\n", "\n"), "\r", "\n")
+	value = norm.NFC.String(strings.ReplaceAll(value, "\uFFFD", ""))
+	sum := md5.Sum([]byte(value))
+
+	return fmt.Sprintf("%d:%s", version, hex.EncodeToString(sum[:]))
+}
+
+// ----------------------------------------------------------------
+
+// serializer the StringChangeLogSerializer of Liquibase, for the changes liquigen generates.
+type serializer struct {
+	properties map[string]string
+}
+
+func (s *serializer) change(change *Element) (string, error) {
+	columns, ok := _checksumChanges[change.Name]
+	if !ok {
+		return "", fmt.Errorf("change %s", change.Name)
+	}
+
+	values := s.fields(change.Attrs, 1)
+	if columns {
+		collection, err := s.columns(change, 2)
+		if err != nil {
+			return "", err
+		}
+		values = append(values, indent(1)+"columns="+collection)
+	} else if len(change.Children) > 0 {
+		return "", fmt.Errorf("change %s, nested %s", change.Name, change.Children[0].Name)
+	}
+
+	return change.Name + ":" + object(values, 1), nil
+}
+
+// visitor the serialized visitor, e.g. replace, its applicableDbms, contextFilter and
+// labels those of the enclosing modifySql.
+func (s *serializer) visitor(modifySQL, visitor *Element) (string, error) {
+	if _, ok := _checksumVisitors[visitor.Name]; !ok {
+		return "", fmt.Errorf("modifySql %s", visitor.Name)
+	}
+
+	values := s.fields(visitor.Attrs, 1)
+	values = append(values, indent(1)+"applyToRollback=\""+strconv.FormatBool(modifySQL.Attrs["applyToRollback"] == "true")+"\"")
+	if dbms := s.expand(modifySQL.Attrs["dbms"]); dbms != "" {
+		var applicable []string
+		for _, it := range strings.Split(dbms, ",") {
+			if it = strings.TrimSpace(it); it != "" {
+				applicable = append(applicable, it)
+			}
+		}
+		values = append(values, indent(1)+"applicableDbms="+collection(applicable, 2))
+	}
+	if context := s.expand(modifySQL.Attrs["context"]); context != "" {
+		values = append(values, indent(1)+"contextFilter=\""+context+"\"")
+	}
+	if labels := s.expand(modifySQL.Attrs["labels"]); labels != "" {
+		values = append(values, indent(1)+"labels=\""+labels+"\"")
+	}
+
+	return visitor.Name + ":" + object(values, 1), nil
+}
+
+// columns the serialized collection of the column elements of the change.
+func (s *serializer) columns(change *Element, level int) (string, error) {
+	var columns []string
+	for _, child := range change.Children {
+		if child.Name != "column" {
+			return "", fmt.Errorf("change %s, nested %s", change.Name, child.Name)
+		}
+
+		values := s.fields(child.Attrs, level+1)
+		for _, nested := range child.Children {
+			if nested.Name != "constraints" || len(nested.Children) > 0 {
+				return "", fmt.Errorf("change %s, column %s, nested %s", change.Name, child.Attrs["name"], nested.Name)
+			}
+
+			values = append(values, indent(level+1)+"constraints="+object(s.fields(nested.Attrs, level+2), level+2))
+		}
+
+		columns = append(columns, "column:"+object(values, level+1))
+	}
+
+	return collection(columns, level), nil
+}
+
+// fields the serialized attributes, name="value", their placeholders expanded.
+func (s *serializer) fields(attrs map[string]string, level int) []string {
+	values := make([]string, 0, len(attrs))
+	for name, value := range attrs {
+		value = s.expand(value)
+		if _, ok := _booleanFields[name]; ok {
+			value = strconv.FormatBool(strings.EqualFold(value, "true"))
+		}
+
+		values = append(values, indent(level)+name+"=\""+value+"\"")
+	}
+
+	return values
+}
+
+// expand substitutes the ${name} properties of value, the unknown ones left as is.
+func (s *serializer) expand(value string) string {
+	return _propertyRegexp.ReplaceAllStringFunc(value, func(match string) string {
+		if value, ok := s.properties[match[2:len(match)-1]]; ok {
+			return value
+		}
+
+		return match
+	})
+}
+
+// ----------------------------------------------------------------
+
+// object the serialized fields, sorted, between brackets.
+func object(values []string, level int) string {
+	sort.Strings(values)
+
+	var buf strings.Builder
+	buf.WriteString("[")
+	if len(values) > 0 {
+		buf.WriteString("\n" + strings.Join(values, "\n") + "\n")
+	}
+	buf.WriteString(indent(level-1) + "]")
+
+	return buf.String()
+}
+
+// collection the serialized items, in order, between brackets.
+func collection(items []string, level int) string {
+	if len(items) == 0 {
+		return "[]"
+	}
+
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, indent(level)+item)
+	}
+
+	return "[\n" + strings.Join(lines, ",\n") + "\n" + indent(level-1) + "]"
+}
+
+func indent(level int) string {
+	return strings.Repeat(" ", ChecksumIndent*level)
+}
@@ -0,0 +1,324 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package liquibase
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestComputeChecksum(t *testing.T) {
+	tests := []struct {
+		name    string
+		version ChecksumVersion
+		value   string
+		want    string
+	}{
+		{name: "md5", version: ChecksumV9, value: "abc", want: "9:900150983cd24fb0d6963f7d28e17f72"},
+		{name: "version 8", version: ChecksumV8, value: "abc", want: "8:900150983cd24fb0d6963f7d28e17f72"},
+		{name: "line endings", version: ChecksumV9, value: "a\r\nb\rc", want: computeChecksum(ChecksumV9, "a\nb\nc")},
+		{name: "nfc", version: ChecksumV9, value: "e\u0301", want: computeChecksum(ChecksumV9, "\u00e9")},
+		{name: "replacement character", version: ChecksumV9, value: "a\uFFFDbc", want: "9:900150983cd24fb0d6963f7d28e17f72"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := computeChecksum(tt.version, tt.value); got != tt.want {
+				t.Errorf("computeChecksum() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSerializer_change(t *testing.T) {
+	s := &serializer{properties: map[string]string{"type.bigint": "BIGINT"}}
+
+	tests := []struct {
+		name   string
+		change *Element
+		want   string
+	}{
+		{
+			name: "createTable",
+			change: &Element{Name: "createTable", Attrs: map[string]string{"tableName": "employee", "remarks": "Employee"},
+				Children: []*Element{
+					{Name: "column", Attrs: map[string]string{"name": "id", "type": "${type.bigint}"},
+						Children: []*Element{{Name: "constraints", Attrs: map[string]string{"nullable": "FALSE", "primaryKey": "true"}}}},
+					{Name: "column", Attrs: map[string]string{"name": "name", "type": "${type.varchar}(64)"}},
+				}},
+			want: `createTable:[
+    columns=[
+        column:[
+            constraints=[
+                nullable="false"
+                primaryKey="true"
+            ]
+            name="id"
+            type="BIGINT"
+        ],
+        column:[
+            name="name"
+            type="${type.varchar}(64)"
+        ]
+    ]
+    remarks="Employee"
+    tableName="employee"
+]`,
+		},
+		{
+			name:   "dropColumn",
+			change: &Element{Name: "dropColumn", Attrs: map[string]string{"tableName": "employee", "columnName": "email"}},
+			want: `dropColumn:[
+    columnName="email"
+    columns=[]
+    tableName="employee"
+]`,
+		},
+		{
+			name:   "dropTable",
+			change: &Element{Name: "dropTable", Attrs: map[string]string{"tableName": "employee"}},
+			want: `dropTable:[
+    tableName="employee"
+]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.change(tt.change)
+			if err != nil {
+				t.Fatalf("change() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("change() got:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSerializer_visitor(t *testing.T) {
+	modifySQL := &Element{Name: "modifySql", Attrs: map[string]string{"dbms": "mysql"}}
+	visitor := &Element{Name: "replace", Attrs: map[string]string{"replace": "timestamp", "with": "TIMESTAMP"}}
+
+	got, err := (&serializer{}).visitor(modifySQL, visitor)
+	if err != nil {
+		t.Fatalf("visitor() error = %v", err)
+	}
+
+	want := `replace:[
+    applicableDbms=[
+        mysql
+    ]
+    applyToRollback="false"
+    replace="timestamp"
+    with="TIMESTAMP"
+]`
+	if got != want {
+		t.Errorf("visitor() got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestChangeSet_Checksum(t *testing.T) {
+	changelog := header + `
+    <changeSet id="employee_001" author="dev">
+        <comment>Initialize the table: employee</comment>
+        <dropTable tableName="employee"/>
+        <modifySql dbms="mysql"><append value=" ENGINE=InnoDB"/></modifySql>
+    </changeSet>
+    <changeSet id="employee_002" author="dev">
+        <sql>DELETE FROM employee</sql>
+    </changeSet>
+</databaseChangeLog>`
+
+	changeSets, err := ReadChangeSets(strings.NewReader(changelog))
+	if err != nil {
+		t.Fatalf("ReadChangeSets() error = %v", err)
+	}
+
+	got, err := changeSets[0].Checksum(ChecksumV9, nil)
+	if err != nil {
+		t.Fatalf("Checksum() error = %v", err)
+	}
+
+	change := computeChecksum(ChecksumV9, "dropTable:[\n    tableName=\"employee\"\n]")
+	visitor := computeChecksum(ChecksumV9, "append:[\n    applicableDbms=[\n        mysql\n    ]\n"+
+		"    applyToRollback=\"false\"\n    value=\" ENGINE=InnoDB\"\n]")
+	if want := computeChecksum(ChecksumV9, change+":"+visitor+";"); got != want {
+		t.Errorf("Checksum() got = %v, want %v", got, want)
+	}
+
+	if v8, _ := changeSets[0].Checksum(ChecksumV8, nil); v8[2:] == got[2:] {
+		t.Errorf("Checksum() version 8 = %v, want it to differ from version 9 %v", v8, got)
+	}
+
+	if _, err = changeSets[1].Checksum(ChecksumV9, nil); !errors.Is(err, ErrUnsupportedChecksum) {
+		t.Errorf("Checksum() error = %v, want %v", err, ErrUnsupportedChecksum)
+	}
+}
+
+func TestChecksumVersionOf(t *testing.T) {
+	tests := []struct {
+		release string
+		want    ChecksumVersion
+	}{
+		{release: "3.10.3", want: ChecksumV8},
+		{release: "4.9.1", want: ChecksumV8},
+		{release: "4.23.2", want: ChecksumV8},
+		{release: "4.24.0", want: ChecksumV9},
+		{release: "4.29.2", want: ChecksumV9},
+		{release: "latest", want: ChecksumV9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.release, func(t *testing.T) {
+			if got := ChecksumVersionOf(tt.release); got != tt.want {
+				t.Errorf("ChecksumVersionOf() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseChecksumVersion(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    ChecksumVersion
+		wantErr bool
+	}{
+		{value: "8", want: ChecksumV8},
+		{value: "v9", want: ChecksumV9},
+		{value: "7", wantErr: true},
+		{value: "x", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseChecksumVersion(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseChecksumVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseChecksumVersion() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestChangeSet_Checksum_golden compares the checksums with those real Liquibase recorded
+// for testdata/checksum/changelog.xml, by testdata/checksum/record.sh.
+func TestChangeSet_Checksum_golden(t *testing.T) {
+	path := filepath.Join("testdata", "checksum", "changelog.xml")
+	changeSets, properties := readGoldenChangelog(t, path)
+
+	for _, version := range []ChecksumVersion{ChecksumV8, ChecksumV9} {
+		t.Run(fmt.Sprintf("v%d", version), func(t *testing.T) {
+			golden, err := readGoldenChecksums(filepath.Join("testdata", "checksum", fmt.Sprintf("v%d.txt", version)))
+			if errors.Is(err, fs.ErrNotExist) {
+				t.Fatalf("no checksums v%d recorded by Liquibase, run testdata/checksum/record.sh", version)
+			}
+			if err != nil {
+				t.Fatalf("readGoldenChecksums() error = %v", err)
+			}
+
+			for _, changeSet := range changeSets {
+				want, ok := golden[changeSet.ID]
+				if !ok {
+					t.Errorf("changeSet %s: no checksum recorded by Liquibase", changeSet.ID)
+					continue
+				}
+
+				got, err := changeSet.Checksum(version, properties)
+				if err != nil {
+					t.Errorf("changeSet %s: Checksum() error = %v", changeSet.ID, err)
+					continue
+				}
+				if got != want {
+					t.Errorf("changeSet %s: Checksum() got = %v, want %v", changeSet.ID, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestChangeSet_Checksum_goldenCoverage every change and modifySql visitor whose checksum
+// is computed has a changeSet in the golden changelog.
+func TestChangeSet_Checksum_goldenCoverage(t *testing.T) {
+	changeSets, _ := readGoldenChangelog(t, filepath.Join("testdata", "checksum", "changelog.xml"))
+
+	covered := make(map[string]bool)
+	for _, changeSet := range changeSets {
+		for _, change := range changeSet.Changes {
+			covered[change.Name] = true
+		}
+		for _, modifySQL := range changeSet.ModifySQL {
+			for _, visitor := range modifySQL.Children {
+				covered[visitor.Name] = true
+			}
+		}
+	}
+
+	for change := range _checksumChanges {
+		if !covered[change] {
+			t.Errorf("change %s: no changeSet in the golden changelog", change)
+		}
+	}
+	if !covered["replace"] {
+		t.Errorf("modifySql replace: no changeSet in the golden changelog")
+	}
+}
+
+func readGoldenChangelog(t *testing.T, path string) ([]*ChangeSet, map[string]string) {
+	t.Helper()
+
+	result, err := Import(path, "mysql")
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer file.Close()
+
+	changeSets, err := ReadChangeSets(file)
+	if err != nil {
+		t.Fatalf("ReadChangeSets() error = %v", err)
+	}
+
+	return changeSets, result.Properties
+}
+
+// readGoldenChecksums reads the "<changeSet id> <MD5SUM>" lines recorded by record.sh.
+func readGoldenChecksums(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	golden := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) == 2 {
+			golden[fields[0]] = fields[1]
+		}
+	}
+
+	return golden, scanner.Err()
+}
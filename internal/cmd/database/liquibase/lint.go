@@ -0,0 +1,424 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package liquibase
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/photowey/liquigen/internal/cmd/database/lint"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	RuleNotChangelog       = "not-changelog"
+	RuleIncludeMissing     = "include-missing"
+	RuleIncludeTwice       = "include-twice"
+	RuleIncludeAllOrder    = "include-all-order"
+	RuleMissingID          = "missing-id"
+	RuleMissingAuthor      = "missing-author"
+	RuleDuplicateChangeSet = "duplicate-changeset"
+	RuleMissingRollback    = "missing-rollback"
+	RuleUndefinedType      = "undefined-type-property"
+	RuleUnknownDbms        = "unknown-dbms"
+	RuleDbmsMismatch       = "dbms-mismatch"
+)
+
+var _typePropertyRegexp = regexp.MustCompile(`\$\{(type\.[^}]+)}`)
+
+var _numberRegexp = regexp.MustCompile(`\d+`)
+
+// _databases the dbms short names of Liquibase, and of the extensions liquigen knows.
+var _databases = map[string]struct{}{
+	"all": {}, "none": {},
+	"asany": {}, "cockroachdb": {}, "db2": {}, "db2z": {}, "derby": {}, "edb": {}, "firebird": {}, "h2": {},
+	"hsqldb": {}, "informix": {}, "ingres": {}, "mariadb": {}, "mssql": {}, "mysql": {}, "oracle": {},
+	"postgresql": {}, "snowflake": {}, "sqlite": {}, "sybase": {},
+	"dm": {}, "kingbase": {},
+}
+
+// _dbmsAliases the usual misspellings of the dbms short names, matching no database.
+var _dbmsAliases = map[string]string{
+	"postgres":    "postgresql",
+	"pg":          "postgresql",
+	"sqlserver":   "mssql",
+	"mssqlserver": "mssql",
+	"maria":       "mariadb",
+	"oracledb":    "oracle",
+}
+
+// _irreversibleChanges the changes Liquibase can't roll back automatically, their changeSet
+// needing a rollback element to be rolled back, which Liquibase does not require.
+var _irreversibleChanges = map[string]struct{}{
+	"alterSequence": {}, "createProcedure": {}, "customChange": {}, "delete": {}, "dropAllForeignKeyConstraints": {},
+	"dropColumn": {}, "dropDefaultValue": {}, "dropForeignKeyConstraint": {}, "dropIndex": {}, "dropPrimaryKey": {},
+	"dropProcedure": {}, "dropSequence": {}, "dropTable": {}, "dropUniqueConstraint": {}, "dropView": {},
+	"executeCommand": {}, "insert": {}, "loadData": {}, "loadUpdateData": {}, "mergeColumns": {},
+	"modifyDataType": {}, "output": {}, "setColumnRemarks": {}, "setTableRemarks": {}, "sql": {}, "sqlFile": {},
+	"stop": {}, "update": {},
+}
+
+// ----------------------------------------------------------------
+
+// LintResult the problems found in a changelog and the changelogs it includes.
+type LintResult struct {
+	// Files the linted changelog files, in order.
+	Files      []string
+	ChangeSets int
+	Problems   []*lint.Problem
+}
+
+// propertyDefinition a property element, for the dbms it is defined for.
+type propertyDefinition struct {
+	Dbms string
+}
+
+type linter struct {
+	dialect    string
+	properties map[string][]*propertyDefinition
+	// included the changelogs walked, by the changelog including them.
+	included   map[string]string
+	changeSets map[string]int
+	result     *LintResult
+}
+
+// ----------------------------------------------------------------
+
+// Lint walks the changelog file, usually a master.xml, and the changelogs it includes, as
+// Import does, and reports:
+//
+//   - the changeSets without id or author, and those defined twice in a file
+//   - the changeSets with a change Liquibase can't roll back, e.g. dropColumn, and no rollback
+//   - the ${type.*} placeholders with no property defined before them for the dbms of the changeSet
+//   - the includeAll whose alphabetical order differs from the numeric one, e.g. v1.10.0 before v1.9.0,
+//     and the changelogs included twice
+//   - the dbms values matching no database, e.g. postgres, and the changeSets, or modifySql, never
+//     running on dialect or on their changeSet
+//
+// An empty dialect skips the checks against the dialect.
+func Lint(path, dialect string) (*LintResult, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &linter{
+		dialect:    strings.ToLower(dialect),
+		properties: make(map[string][]*propertyDefinition),
+		included:   make(map[string]string),
+		changeSets: make(map[string]int),
+		result:     &LintResult{},
+	}
+
+	if _, err = os.Stat(path); err != nil {
+		return nil, err
+	}
+	l.lintFile(path, "")
+
+	return l.result, nil
+}
+
+// ----------------------------------------------------------------
+
+func (l *linter) lintFile(path, from string) {
+	if first, ok := l.included[path]; ok {
+		l.report(lint.Warning, RuleIncludeTwice, from, 0,
+			fmt.Sprintf("%s already included by %s, its changeSets are parsed twice", path, first))
+
+		return
+	}
+	l.included[path] = from
+
+	file, err := os.Open(path)
+	if err != nil {
+		l.report(lint.Error, RuleIncludeMissing, from, 0, err.Error())
+
+		return
+	}
+	defer file.Close()
+
+	root, err := parse(file)
+	if err != nil {
+		l.report(lint.Error, RuleNotChangelog, path, 0, fmt.Sprintf("parse changelog failed: %v", err))
+
+		return
+	}
+	if root.Name != "databaseChangeLog" {
+		l.report(lint.Error, RuleNotChangelog, path, root.Line, fmt.Sprintf("root element %s", root.Name))
+
+		return
+	}
+
+	l.result.Files = append(l.result.Files, path)
+
+	for _, child := range root.Children {
+		switch child.Name {
+		case "property":
+			l.property(path, child)
+		case "include":
+			l.include(path, child)
+		case "includeAll":
+			l.includeAll(path, child)
+		case "changeSet":
+			l.changeSet(path, child)
+		}
+	}
+}
+
+func (l *linter) property(path string, n *node) {
+	name := n.Attrs["name"]
+	if name == "" {
+		return
+	}
+
+	l.dbms(path, n.Line, n.Attrs["dbms"])
+	l.properties[name] = append(l.properties[name], &propertyDefinition{Dbms: n.Attrs["dbms"]})
+}
+
+func (l *linter) include(path string, n *node) {
+	file, err := resolve(path, n.Attrs["file"], n.Attrs["relativeToChangelogFile"] == "true")
+	if err != nil {
+		l.report(lint.Error, RuleIncludeMissing, path, n.Line, fmt.Sprintf("include %s: %v", n.Attrs["file"], err))
+
+		return
+	}
+
+	if strings.EqualFold(filepath.Ext(file), ChangelogSuffix) {
+		l.lintFile(file, path)
+	}
+}
+
+func (l *linter) includeAll(path string, n *node) {
+	dir, err := resolve(path, n.Attrs["path"], n.Attrs["relativeToChangelogFile"] == "true")
+	if err == nil {
+		_, err = os.Stat(dir)
+	}
+	if err != nil {
+		l.report(lint.Error, RuleIncludeMissing, path, n.Line, fmt.Sprintf("includeAll %s: %v", n.Attrs["path"], err))
+
+		return
+	}
+
+	var files []string
+	_ = filepath.WalkDir(dir, func(file string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() && strings.EqualFold(filepath.Ext(file), ChangelogSuffix) {
+			files = append(files, file)
+		}
+
+		return nil
+	})
+	sort.Strings(files)
+
+	for i := 1; i < len(files); i++ {
+		before, after := relative(dir, files[i-1]), relative(dir, files[i])
+		if naturalLess(after, before) {
+			l.report(lint.Warning, RuleIncludeAllOrder, path, n.Line, fmt.Sprintf(
+				"includeAll %s runs %s before %s, the files run in alphabetical order, zero-pad the numbers",
+				n.Attrs["path"], before, after))
+		}
+	}
+
+	for _, file := range files {
+		l.lintFile(file, path)
+	}
+}
+
+func (l *linter) changeSet(path string, n *node) {
+	l.result.ChangeSets++
+
+	id, author := n.Attrs["id"], n.Attrs["author"]
+	if strings.TrimSpace(id) == "" {
+		l.report(lint.Error, RuleMissingID, path, n.Line, "changeSet without id")
+	}
+	if strings.TrimSpace(author) == "" {
+		l.report(lint.Error, RuleMissingAuthor, path, n.Line, fmt.Sprintf("changeSet %s without author", id))
+	}
+
+	key := path + "::" + id + "::" + author
+	if line, ok := l.changeSets[key]; ok {
+		l.report(lint.Error, RuleDuplicateChangeSet, path, n.Line,
+			fmt.Sprintf("changeSet %s::%s already defined at line %d", id, author, line))
+	} else {
+		l.changeSets[key] = n.Line
+	}
+
+	dbms := n.Attrs["dbms"]
+	l.dbms(path, n.Line, dbms)
+	if l.dialect != "" && !MatchDbms(dbms, l.dialect) {
+		l.report(lint.Error, RuleDbmsMismatch, path, n.Line,
+			fmt.Sprintf("changeSet %s never runs on %s, its dbms is %q", id, l.dialect, dbms))
+	}
+
+	rollback, irreversible := false, ""
+	for _, child := range n.Children {
+		switch child.Name {
+		case "rollback":
+			rollback = true
+		case "modifySql":
+			l.dbms(path, child.Line, child.Attrs["dbms"])
+			if !overlaps(dbms, child.Attrs["dbms"]) {
+				l.report(lint.Error, RuleDbmsMismatch, path, child.Line,
+					fmt.Sprintf("modifySql of changeSet %s never applies, its dbms %q excludes the changeSet dbms %q",
+						id, child.Attrs["dbms"], dbms))
+			}
+		}
+		if _, ok := _irreversibleChanges[child.Name]; ok && irreversible == "" {
+			irreversible = child.Name
+		}
+
+		l.typeProperties(path, id, dbms, child)
+	}
+
+	if irreversible != "" && !rollback {
+		l.report(lint.Warning, RuleMissingRollback, path, n.Line,
+			fmt.Sprintf("changeSet %s has no rollback, Liquibase can't roll %s back", id, irreversible))
+	}
+}
+
+// typeProperties reports the ${type.*} placeholders of the element with no property
+// defined for the dbms of the changeSet, each dbms of a list checked.
+func (l *linter) typeProperties(path, id, dbms string, n *node) {
+	values := []string{n.Text}
+	for _, value := range n.Attrs {
+		values = append(values, value)
+	}
+
+	for _, value := range values {
+		for _, match := range _typePropertyRegexp.FindAllStringSubmatch(value, -1) {
+			for _, missing := range l.undefinedFor(match[1], dbms) {
+				l.report(lint.Error, RuleUndefinedType, path, n.Line,
+					fmt.Sprintf("changeSet %s uses ${%s}, no property defined before it for %s", id, match[1], missing))
+			}
+		}
+	}
+
+	for _, child := range n.Children {
+		l.typeProperties(path, id, dbms, child)
+	}
+}
+
+// undefinedFor the databases of dbms, or the dialect, the property is not defined for,
+// "any database" when it is not defined at all.
+func (l *linter) undefinedFor(name, dbms string) []string {
+	definitions := l.properties[name]
+
+	var databases []string
+	if l.dialect != "" {
+		databases = []string{l.dialect}
+	} else {
+		for _, it := range strings.Split(dbms, ",") {
+			if it = strings.ToLower(strings.TrimSpace(it)); it != "" && it != "all" && !strings.HasPrefix(it, "!") {
+				databases = append(databases, it)
+			}
+		}
+	}
+
+	if len(databases) == 0 {
+		if len(definitions) == 0 {
+			return []string{"any database"}
+		}
+
+		return nil
+	}
+
+	var missing []string
+	for _, database := range databases {
+		defined := false
+		for _, definition := range definitions {
+			defined = defined || MatchDbms(definition.Dbms, database)
+		}
+		if !defined {
+			missing = append(missing, database)
+		}
+	}
+
+	return missing
+}
+
+// dbms reports the values of the dbms attribute matching no database.
+func (l *linter) dbms(path string, line int, dbms string) {
+	for _, it := range strings.Split(dbms, ",") {
+		it = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(it), "!"))
+		if it == "" {
+			continue
+		}
+		if _, ok := _databases[it]; ok {
+			continue
+		}
+
+		if name, ok := _dbmsAliases[it]; ok {
+			l.report(lint.Error, RuleUnknownDbms, path, line, fmt.Sprintf("dbms %q matches no database, Liquibase names it %q", it, name))
+		} else {
+			l.report(lint.Warning, RuleUnknownDbms, path, line, fmt.Sprintf("dbms %q is not a Liquibase database", it))
+		}
+	}
+}
+
+func (l *linter) report(severity lint.Severity, rule, path string, line int, message string) {
+	l.result.Problems = append(l.result.Problems, &lint.Problem{
+		Rule:     rule,
+		Severity: severity,
+		File:     path,
+		Line:     line,
+		Message:  message,
+	})
+}
+
+// ----------------------------------------------------------------
+
+// overlaps reports whether a changeSet of the dbms may apply the modifySql of the dbms other.
+func overlaps(dbms, other string) bool {
+	if strings.TrimSpace(other) == "" || strings.TrimSpace(dbms) == "" {
+		return true
+	}
+
+	for _, it := range strings.Split(dbms, ",") {
+		it = strings.ToLower(strings.TrimSpace(it))
+		if it != "" && !strings.HasPrefix(it, "!") && MatchDbms(other, it) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// naturalLess compares the paths, their numbers compared by value, e.g. v1.9.0 before v1.10.0.
+func naturalLess(a, b string) bool {
+	pad := func(value string) string {
+		return _numberRegexp.ReplaceAllStringFunc(value, func(number string) string {
+			number = strings.TrimLeft(number, "0")
+			return strconv.Itoa(len(number)) + ":" + number
+		})
+	}
+
+	return pad(a) < pad(b)
+}
+
+func relative(dir, path string) string {
+	if rel, err := filepath.Rel(dir, path); err == nil {
+		return filepath.ToSlash(rel)
+	}
+
+	return path
+}
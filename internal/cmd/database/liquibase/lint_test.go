@@ -0,0 +1,179 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package liquibase
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/photowey/liquigen/internal/cmd/database/lint"
+)
+
+func TestLint(t *testing.T) {
+	root := writeChangelogs(t, map[string]string{
+		"liquibase/global/types.xml": `
+    <property name="type.varchar" value="VARCHAR"/>
+    <property name="type.bigint" value="BIGINT" dbms="mysql"/>
+`,
+		"liquibase/mysql/master.xml": `
+    <include file="classpath:liquibase/global/types.xml"/>
+    <includeAll path="changelogs" relativeToChangelogFile="true"/>
+    <include file="changelogs/v1.9.0/employee.xml" relativeToChangelogFile="true"/>
+`,
+		"liquibase/mysql/changelogs/v1.9.0/employee.xml": `
+    <changeSet id="employee_001" author="dev" dbms="mysql">
+        <createTable tableName="employee">
+            <column name="id" type="${type.bigint}"/>
+            <column name="name" type="${type.varchar}(64)"/>
+        </createTable>
+        <modifySql dbms="postgresql"><append value=" ENGINE=InnoDB"/></modifySql>
+    </changeSet>
+    <changeSet id="employee_001" author="dev" dbms="postgres">
+        <dropColumn tableName="employee" columnName="name"/>
+    </changeSet>
+`,
+		"liquibase/mysql/changelogs/v1.10.0/employee.xml": `
+    <changeSet id="employee_002">
+        <dropTable tableName="employee"/>
+        <rollback/>
+    </changeSet>
+    <changeSet id="employee_003" author="dev" dbms="postgresql,mysql">
+        <addColumn tableName="employee">
+            <column name="org_id" type="${type.bigint}"/>
+        </addColumn>
+    </changeSet>
+`,
+	})
+
+	result, err := Lint(filepath.Join(root, "liquibase", "mysql", "master.xml"), "")
+	if err != nil {
+		t.Fatalf("Lint() error = %v", err)
+	}
+
+	var got []string
+	for _, problem := range result.Problems {
+		got = append(got, problem.Severity.String()+" "+problem.Rule+" "+filepath.Base(filepath.Dir(problem.File))+":"+problem.Message)
+	}
+	sort.Strings(got)
+
+	want := []string{
+		"error dbms-mismatch v1.9.0:modifySql of changeSet employee_001 never applies, its dbms \"postgresql\" excludes the changeSet dbms \"mysql\"",
+		"error duplicate-changeset v1.9.0:changeSet employee_001::dev already defined at line 4",
+		"error missing-author v1.10.0:changeSet employee_002 without author",
+		"error undefined-type-property v1.10.0:changeSet employee_003 uses ${type.bigint}, no property defined before it for postgresql",
+		"error unknown-dbms v1.9.0:dbms \"postgres\" matches no database, Liquibase names it \"postgresql\"",
+		"warning include-all-order mysql:includeAll changelogs runs v1.10.0/employee.xml before v1.9.0/employee.xml, " +
+			"the files run in alphabetical order, zero-pad the numbers",
+		"warning include-twice mysql:" + filepath.Join(root, "liquibase", "mysql", "changelogs", "v1.9.0", "employee.xml") +
+			" already included by " + filepath.Join(root, "liquibase", "mysql", "master.xml") + ", its changeSets are parsed twice",
+		"warning missing-rollback v1.9.0:changeSet employee_001 has no rollback, Liquibase can't roll dropColumn back",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Lint() got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if !lint.Failed(result.Problems, lint.Error) {
+		t.Errorf("Lint() got no error")
+	}
+}
+
+func TestLint_withoutRollback(t *testing.T) {
+	root := writeChangelogs(t, map[string]string{
+		"master.xml": `
+    <changeSet id="employee_001" author="dev">
+        <dropIndex tableName="employee" indexName="idx_employee_email"/>
+        <dropColumn tableName="employee" columnName="email"/>
+    </changeSet>
+    <changeSet id="employee_002" author="dev">
+        <modifyDataType tableName="employee" columnName="name" newDataType="VARCHAR(128)"/>
+        <setColumnRemarks tableName="employee" columnName="name" columnDataType="VARCHAR(128)" remarks="Name"/>
+    </changeSet>
+`,
+	})
+
+	result, err := Lint(filepath.Join(root, "master.xml"), "mysql")
+	if err != nil {
+		t.Fatalf("Lint() error = %v", err)
+	}
+
+	if lint.Failed(result.Problems, lint.Error) {
+		t.Errorf("Lint() got errors %v, want the missing rollbacks as warnings", result.Problems)
+	}
+	if len(result.Problems) != 2 {
+		t.Errorf("Lint() got %d problems, want 2 missing rollbacks", len(result.Problems))
+	}
+}
+
+func TestLint_dialect(t *testing.T) {
+	root := writeChangelogs(t, map[string]string{
+		"master.xml": `
+    <property name="type.bigint" value="BIGINT" dbms="mysql"/>
+    <changeSet id="employee_001" author="dev">
+        <addColumn tableName="employee">
+            <column name="org_id" type="${type.bigint}"/>
+        </addColumn>
+    </changeSet>
+    <changeSet id="employee_002" author="dev" dbms="mysql">
+        <renameTable oldTableName="employee" newTableName="staff"/>
+    </changeSet>
+`,
+	})
+
+	tests := []struct {
+		dialect string
+		want    []string
+	}{
+		{dialect: "mysql"},
+		{dialect: "postgresql", want: []string{RuleUndefinedType, RuleDbmsMismatch}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			result, err := Lint(filepath.Join(root, "master.xml"), tt.dialect)
+			if err != nil {
+				t.Fatalf("Lint() error = %v", err)
+			}
+
+			var got []string
+			for _, problem := range result.Problems {
+				got = append(got, problem.Rule)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Lint() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNaturalLess(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "v1.9.0/a.xml", b: "v1.10.0/a.xml", want: true},
+		{a: "v1.10.0/a.xml", b: "v1.9.0/a.xml", want: false},
+		{a: "009_a.xml", b: "10_a.xml", want: true},
+		{a: "a.xml", b: "b.xml", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"<"+tt.b, func(t *testing.T) {
+			if got := naturalLess(tt.a, tt.b); got != tt.want {
+				t.Errorf("naturalLess() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
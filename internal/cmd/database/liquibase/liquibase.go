@@ -0,0 +1,425 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package liquibase
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	ClasspathPrefix    = "classpath:"
+	ClasspathAllPrefix = "classpath*:"
+	ChangelogSuffix    = ".xml"
+)
+
+var _propertyRegexp = regexp.MustCompile(`\$\{([^}]+)}`)
+
+// ----------------------------------------------------------------
+
+// Result the schema rebuilt from a changelog, and the changes liquigen can't model.
+type Result struct {
+	Database *ast.Database
+	// Files the imported changelog files, in order.
+	Files []string
+	// Properties the properties defined for the dialect, the first definition winning.
+	Properties map[string]string
+	ChangeSets int
+	// Unsupported the changes left out of Database.
+	Unsupported []*Unsupported
+}
+
+// Unsupported a change, or an included file, left out of the rebuilt schema.
+type Unsupported struct {
+	File      string
+	ChangeSet string
+	Change    string
+	Reason    string
+}
+
+func (u *Unsupported) String() string {
+	if u.ChangeSet == "" {
+		return fmt.Sprintf("%s: %s", u.File, u.Reason)
+	}
+
+	return fmt.Sprintf("%s#%s: %s, %s", u.File, u.ChangeSet, u.Change, u.Reason)
+}
+
+// ----------------------------------------------------------------
+
+// node one element of a changelog.
+type node struct {
+	Name  string
+	Attrs map[string]string
+	// Text the character data of the element, e.g. the SQL of a sql change.
+	Text     string
+	Children []*node
+	// Line the line of the start tag.
+	Line int
+}
+
+type importer struct {
+	dialect    string
+	properties map[string]string
+	visited    map[string]bool
+	result     *Result
+}
+
+// ----------------------------------------------------------------
+
+// Import rebuilds the schema described by the changelog file, usually a master.xml,
+// by replaying its changeSets in order.
+//
+// The include and includeAll elements are followed, their file and path resolved
+// against the including changelog for relativeToChangelogFile="true", and against the
+// directories above it, the classpath roots, otherwise. The changeSets and properties
+// whose dbms excludes dialect are skipped, an empty dialect keeps them all.
+//
+// The changes liquigen can't model, e.g. sql or insert, are reported in Result.Unsupported.
+func Import(path, dialect string) (*Result, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &importer{
+		dialect:    dialect,
+		properties: make(map[string]string),
+		visited:    make(map[string]bool),
+		result: &Result{
+			Database: &ast.Database{},
+		},
+	}
+
+	if err = i.importFile(path); err != nil {
+		return nil, err
+	}
+	i.result.Properties = i.properties
+
+	return i.result, nil
+}
+
+// ReadProperties reads the properties of one changelog document defined for the
+// dialect, e.g. the ${type.bigint} of the global types changelog.
+func ReadProperties(r io.Reader, dialect string) (map[string]string, error) {
+	root, err := parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("liquibase: parse changelog failed: %w", err)
+	}
+	if root.Name != "databaseChangeLog" {
+		return nil, fmt.Errorf("%w, root element %s", ErrNotChangelog, root.Name)
+	}
+
+	i := &importer{dialect: dialect, properties: make(map[string]string)}
+	for _, child := range root.Children {
+		if child.Name == "property" {
+			i.property(child)
+		}
+	}
+
+	return i.properties, nil
+}
+
+// ----------------------------------------------------------------
+
+func (i *importer) importFile(path string) error {
+	if i.visited[path] {
+		return nil
+	}
+	i.visited[path] = true
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	root, err := parse(file)
+	if err != nil {
+		return fmt.Errorf("liquibase: parse changelog %s failed: %w", path, err)
+	}
+	if root.Name != "databaseChangeLog" {
+		return fmt.Errorf("liquibase: %s is not a changelog, root element %s", path, root.Name)
+	}
+
+	i.result.Files = append(i.result.Files, path)
+
+	for _, child := range root.Children {
+		switch child.Name {
+		case "property":
+			i.property(child)
+		case "include":
+			if err = i.include(path, child); err != nil {
+				return err
+			}
+		case "includeAll":
+			if err = i.includeAll(path, child); err != nil {
+				return err
+			}
+		case "changeSet":
+			i.changeSet(path, child)
+		}
+	}
+
+	return nil
+}
+
+func (i *importer) property(n *node) {
+	name := n.Attrs["name"]
+	if name == "" || !i.matchDbms(n.Attrs["dbms"]) {
+		return
+	}
+
+	// The first definition wins, as in Liquibase.
+	if _, ok := i.properties[name]; !ok {
+		i.properties[name] = n.Attrs["value"]
+	}
+}
+
+func (i *importer) include(path string, n *node) error {
+	file, err := resolve(path, i.expand(n.Attrs["file"]), n.Attrs["relativeToChangelogFile"] == "true")
+	if err != nil {
+		return fmt.Errorf("liquibase: include of %s failed: %w", path, err)
+	}
+
+	if !strings.EqualFold(filepath.Ext(file), ChangelogSuffix) {
+		i.unsupported(&Unsupported{File: file, Reason: "only XML changelogs supported"})
+
+		return nil
+	}
+
+	return i.importFile(file)
+}
+
+func (i *importer) includeAll(path string, n *node) error {
+	dir, err := resolve(path, i.expand(n.Attrs["path"]), n.Attrs["relativeToChangelogFile"] == "true")
+	if err != nil {
+		return fmt.Errorf("liquibase: includeAll of %s failed: %w", path, err)
+	}
+
+	var files []string
+	err = filepath.WalkDir(dir, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		files = append(files, file)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("liquibase: includeAll of %s failed: %w", path, err)
+	}
+
+	sort.Strings(files)
+
+	for _, file := range files {
+		if !strings.EqualFold(filepath.Ext(file), ChangelogSuffix) {
+			i.unsupported(&Unsupported{File: file, Reason: "only XML changelogs supported"})
+
+			continue
+		}
+
+		if err = i.importFile(file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *importer) changeSet(path string, n *node) {
+	if !i.matchDbms(n.Attrs["dbms"]) {
+		return
+	}
+
+	i.result.ChangeSets++
+
+	for _, change := range n.Children {
+		if _, ok := _ignored[change.Name]; ok {
+			continue
+		}
+
+		if err := i.apply(change); err != nil {
+			i.unsupported(&Unsupported{
+				File:      path,
+				ChangeSet: n.Attrs["id"],
+				Change:    change.Name,
+				Reason:    err.Error(),
+			})
+		}
+	}
+}
+
+func (i *importer) unsupported(u *Unsupported) {
+	i.result.Unsupported = append(i.result.Unsupported, u)
+}
+
+func (i *importer) matchDbms(dbms string) bool {
+	return MatchDbms(dbms, i.dialect)
+}
+
+// MatchDbms reports whether the dbms attribute, e.g. "mysql,postgresql" or "!oracle",
+// accepts the dialect, an empty dialect accepting them all.
+func MatchDbms(dbms, dialect string) bool {
+	dbms = strings.TrimSpace(dbms)
+	if dialect == "" || dbms == "" || dbms == "all" {
+		return true
+	}
+
+	dialects := []string{strings.ToLower(dialect)}
+	if dialects[0] == "postgres" {
+		dialects = append(dialects, "postgresql")
+	}
+
+	included, excludedOnly := false, true
+	for _, it := range strings.Split(strings.ToLower(dbms), ",") {
+		it = strings.TrimSpace(it)
+		if strings.HasPrefix(it, "!") {
+			if contains(dialects, strings.TrimPrefix(it, "!")) {
+				return false
+			}
+
+			continue
+		}
+
+		excludedOnly = false
+		if contains(dialects, it) {
+			included = true
+		}
+	}
+
+	return included || excludedOnly
+}
+
+// expand substitutes the ${name} properties of value, the unknown type properties
+// of liquigen, e.g. ${type.varchar}, falling back to the type name.
+func (i *importer) expand(value string) string {
+	return _propertyRegexp.ReplaceAllStringFunc(value, func(match string) string {
+		name := match[2 : len(match)-1]
+		if value, ok := i.properties[name]; ok {
+			return value
+		}
+		if strings.HasPrefix(name, "type.") {
+			return strings.TrimPrefix(name, "type.")
+		}
+
+		return match
+	})
+}
+
+// ----------------------------------------------------------------
+
+// resolve the absolute path of a file included by the changelog base.
+func resolve(base, path string, relative bool) (string, error) {
+	if path == "" {
+		return "", errors.New("missing file or path")
+	}
+
+	path = strings.TrimPrefix(strings.TrimPrefix(path, ClasspathAllPrefix), ClasspathPrefix)
+	path = filepath.FromSlash(path)
+
+	if relative {
+		return filepath.Join(filepath.Dir(base), path), nil
+	}
+	if filepath.IsAbs(path) {
+		return path, nil
+	}
+
+	for dir := filepath.Dir(base); ; dir = filepath.Dir(dir) {
+		candidate := filepath.Join(dir, path)
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+
+		if parent := filepath.Dir(dir); parent == dir {
+			break
+		}
+	}
+
+	return "", fmt.Errorf("%s not found", path)
+}
+
+func parse(r io.Reader) (*node, error) {
+	decoder := xml.NewDecoder(r)
+
+	var (
+		root  *node
+		stack []*node
+	)
+
+	for {
+		line, _ := decoder.InputPos()
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch it := token.(type) {
+		case xml.StartElement:
+			n := &node{Name: it.Name.Local, Attrs: make(map[string]string, len(it.Attr)), Line: line}
+			for _, attr := range it.Attr {
+				n.Attrs[attr.Name.Local] = attr.Value
+			}
+
+			if len(stack) == 0 {
+				root = n
+			} else {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, n)
+			}
+			stack = append(stack, n)
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].Text += string(it)
+			}
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if root == nil {
+		return nil, errors.New("empty document")
+	}
+
+	return root, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, it := range values {
+		if it == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,259 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package liquibase
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+)
+
+const (
+	header = `<?xml version="1.0" encoding="UTF-8"?>
+<databaseChangeLog xmlns="http://www.liquibase.org/xml/ns/dbchangelog">
+`
+	footer = `</databaseChangeLog>
+`
+)
+
+func writeChangelogs(t *testing.T, files map[string]string) string {
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(header+content+footer), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return root
+}
+
+func TestImport(t *testing.T) {
+	root := writeChangelogs(t, map[string]string{
+		"liquibase/global/types.xml": `
+    <property name="type.varchar" value="VARCHAR"/>
+    <property name="type.bigint" value="BIGINT" dbms="mysql"/>
+    <property name="type.bigint" value="INT8" dbms="postgresql"/>
+`,
+		"liquibase/mysql/master.xml": `
+    <include file="classpath:liquibase/global/types.xml"/>
+    <includeAll path="changelogs" relativeToChangelogFile="true"/>
+`,
+		"liquibase/mysql/changelogs/v1.0.0/employee_1.0.0.xml": `
+    <changeSet id="employee_001" author="photowey" dbms="mysql">
+        <comment>Initialize the table: employee</comment>
+        <createTable tableName="employee" remarks="Employee">
+            <column name="id" type="${type.bigint}" autoIncrement="true" remarks="ID">
+                <constraints primaryKey="true"/>
+            </column>
+            <column name="org_name" type="${type.varchar}(64)" remarks="Org">
+                <constraints nullable="false"/>
+            </column>
+            <column name="balance" type="decimal(16, 2)" defaultValueNumeric="0"/>
+            <column name="update_time" type="${type.timestamp}"
+                    defaultValueComputed="CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP"/>
+        </createTable>
+        <createIndex tableName="employee" indexName="idx_org_name">
+            <column name="org_name"/>
+        </createIndex>
+    </changeSet>
+    <changeSet id="employee_002" author="photowey" dbms="postgresql">
+        <dropTable tableName="employee"/>
+    </changeSet>
+`,
+		"liquibase/mysql/changelogs/v1.0.1/employee_1.0.1.xml": `
+    <changeSet id="employee_003" author="photowey">
+        <renameColumn tableName="employee" oldColumnName="org_name" newColumnName="organization_name"/>
+        <addColumn tableName="employee">
+            <column name="employee_no" type="varchar(32)" afterColumn="id"/>
+        </addColumn>
+        <dropColumn tableName="employee" columnName="balance"/>
+        <modifyDataType tableName="employee" columnName="organization_name" newDataType="varchar(128)"/>
+        <sql>UPDATE employee SET employee_no = id</sql>
+    </changeSet>
+`,
+		"liquibase/mysql/changelogs/v1.0.1/README.md": "",
+	})
+
+	result, err := Import(filepath.Join(root, "liquibase/mysql/master.xml"), "mysql")
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	if len(result.Files) != 4 || result.ChangeSets != 2 {
+		t.Errorf("Import() got %d files, %d changeSets, want 4, 2", len(result.Files), result.ChangeSets)
+	}
+
+	want := []*ast.Table{
+		{
+			Name:    "employee",
+			Comment: "Employee",
+			Columns: []*ast.Column{
+				{Name: "id", DataType: "bigint", AutoIncrement: true, PrimaryKey: true, NotNull: true, Comment: "ID"},
+				{Name: "employee_no", DataType: "varchar", Length: intPtr(32)},
+				{Name: "organization_name", DataType: "varchar", Length: intPtr(128), NotNull: true, Comment: "Org"},
+				{Name: "update_time", DataType: "timestamp", Default: "CURRENT_TIMESTAMP", UpdateTimestamp: true},
+			},
+			Indexes: []*ast.Index{{Name: "idx_org_name", Columns: []string{"organization_name"}}},
+		},
+	}
+	if !reflect.DeepEqual(result.Database.Tables, want) {
+		t.Errorf("Import() got tables = %v, want %v", result.Database.Tables[0].Columns, want[0].Columns)
+	}
+
+	var unsupported []string
+	for _, it := range result.Unsupported {
+		unsupported = append(unsupported, it.ChangeSet+" "+it.Change+" "+filepath.Base(it.File))
+	}
+	wantUnsupported := []string{"  README.md", "employee_003 sql employee_1.0.1.xml"}
+	if !reflect.DeepEqual(unsupported, wantUnsupported) {
+		t.Errorf("Import() got unsupported = %v, want %v", unsupported, wantUnsupported)
+	}
+}
+
+func TestImport_Missing(t *testing.T) {
+	root := writeChangelogs(t, map[string]string{
+		"master.xml": `<include file="missing.xml"/>`,
+	})
+
+	if _, err := Import(filepath.Join(root, "master.xml"), ""); err == nil {
+		t.Errorf("Import() want error")
+	}
+}
+
+func TestPopulateDataType(t *testing.T) {
+	tests := []struct {
+		name     string
+		dataType string
+		want     *ast.Column
+	}{
+		{name: "test varchar", dataType: "VARCHAR(32)", want: &ast.Column{DataType: "varchar", Length: intPtr(32)}},
+		{name: "test decimal", dataType: "decimal(16, 2)", want: &ast.Column{DataType: "decimal", Precision: intPtr(16), Scale: intPtr(2)}},
+		{name: "test numeric", dataType: "NUMERIC(10)", want: &ast.Column{DataType: "decimal", Precision: intPtr(10)}},
+		{name: "test unsigned", dataType: "bigint(20) unsigned", want: &ast.Column{DataType: "bigint", Length: intPtr(20), Unsigned: true}},
+		{name: "test integer", dataType: "java.sql.Types.INTEGER", want: &ast.Column{DataType: "int"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &ast.Column{}
+			if err := populateDataType(got, tt.dataType); err != nil {
+				t.Fatalf("populateDataType() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("populateDataType() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImporter_matchDbms(t *testing.T) {
+	tests := []struct {
+		dialect string
+		dbms    string
+		want    bool
+	}{
+		{dialect: "mysql", dbms: "", want: true},
+		{dialect: "mysql", dbms: "mysql,oracle", want: true},
+		{dialect: "mysql", dbms: "oracle", want: false},
+		{dialect: "mysql", dbms: "!oracle", want: true},
+		{dialect: "mysql", dbms: "!mysql", want: false},
+		{dialect: "postgres", dbms: "postgresql", want: true},
+		{dialect: "", dbms: "oracle", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect+"_"+tt.dbms, func(t *testing.T) {
+			i := &importer{dialect: tt.dialect}
+			if got := i.matchDbms(tt.dbms); got != tt.want {
+				t.Errorf("matchDbms() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func intPtr(x int) *int {
+	return &x
+}
+
+func TestImport_columns(t *testing.T) {
+	changelog := `
+    <changeSet id="employee_001" author="photowey">
+        <createTable tableName="employee">
+            <column name="id" type="bigint"/>
+            <column name="org_id" type="bigint"/>
+            <column name="org_name" type="varchar(64)"/>
+            <column name="email" type="varchar(128)"/>
+        </createTable>
+        <createIndex tableName="employee" indexName="idx_org">
+            <column name="org_id"/>
+            <column name="org_name"/>
+        </createIndex>
+        <createIndex tableName="employee" indexName="idx_email">
+            <column name="email"/>
+        </createIndex>
+    </changeSet>
+    <changeSet id="employee_002" author="photowey">
+        <addColumn tableName="employee">
+            <column name="employee_no" type="varchar(32)" afterColumn="missing"/>
+            <column name="name" type="varchar(64)" beforeColumn="org_id"/>
+        </addColumn>
+        <dropColumn tableName="employee">
+            <column name="org_name"/>
+            <column name="email"/>
+        </dropColumn>
+    </changeSet>
+`
+
+	tests := []struct {
+		dialect     string
+		wantIndexes []*ast.Index
+	}{
+		{dialect: "mysql", wantIndexes: []*ast.Index{{Name: "idx_org", Columns: []string{"org_id"}}}},
+		{dialect: "postgres"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			root := writeChangelogs(t, map[string]string{"master.xml": changelog})
+			result, err := Import(filepath.Join(root, "master.xml"), tt.dialect)
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+
+			table := result.Database.Tables[0]
+			var columns []string
+			for _, column := range table.Columns {
+				columns = append(columns, column.Name)
+			}
+			if want := []string{"id", "name", "org_id", "employee_no"}; !reflect.DeepEqual(columns, want) {
+				t.Errorf("Import() got columns = %v, want %v", columns, want)
+			}
+			if !reflect.DeepEqual(table.Indexes, tt.wantIndexes) {
+				t.Errorf("Import() got indexes = %v, want %v", table.Indexes, tt.wantIndexes)
+			}
+
+			if len(result.Unsupported) != 1 || result.Unsupported[0].Change != "addColumn" ||
+				result.Unsupported[0].Reason != "column position not found: afterColumn missing of employee_no of table employee, added last" {
+				t.Errorf("Import() got unsupported = %v, want the afterColumn of employee_no", result.Unsupported)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+#!/usr/bin/env bash
+#
+# Records the MD5SUM real Liquibase computes for the changeSets of changelog.xml, in v8.txt
+# with Liquibase 4.23, the last release of the checksum version 8, and in v9.txt with 4.24,
+# the first of the version 9. Each line is "<changeSet id> <MD5SUM>".
+#
+# The offline update-sql of Liquibase writes the INSERT INTO DATABASECHANGELOG of every
+# changeSet, the MD5SUM included, so no database is needed, only docker.
+
+set -euo pipefail
+
+cd "$(dirname "$0")"
+
+record() {
+  local release="$1" file="$2"
+
+  docker run --rm -v "$PWD:/liquibase/changelog" "liquibase/liquibase:${release}" \
+    --search-path=/liquibase/changelog --changelog-file=changelog.xml --url=offline:mysql update-sql |
+    sed -n "s/^INSERT INTO .*DATABASECHANGELOG .* VALUES ('\([^']*\)', '[^']*', '[^']*', [^,]*, [0-9]*, '\([^']*\)'.*/\1 \2/p" >"${file}"
+
+  if [ ! -s "${file}" ]; then
+    echo "liquibase ${release}: no checksum recorded" >&2
+    rm -f "${file}"
+    exit 1
+  fi
+  echo "liquibase ${release}: $(wc -l <"${file}") checksums -> ${file}"
+}
+
+record 4.23.2 v8.txt
+record 4.24.0 v9.txt
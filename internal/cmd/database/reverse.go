@@ -15,3 +15,195 @@
  */
 
 package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	DefaultConcurrency = 8
+)
+
+// ----------------------------------------------------------------
+
+var (
+	_reverserRegistry                  = NewReverserRegistry()
+	_                 ReverserRegistry = (*reverserRegistry)(nil)
+)
+
+// ----------------------------------------------------------------
+
+// Reverser introspects the table metadata of a live database.
+type Reverser interface {
+	Dialect() string
+	Driver() string
+	DSN(args *Args) string
+	// Tables lists the base table names of the target database, in a stable order.
+	Tables(ctx context.Context, db *sql.DB, database string) ([]string, error)
+	// Table fetches the comment, columns and indexes of one table.
+	Table(ctx context.Context, db *sql.DB, database, table string) (*ast.Table, error)
+}
+
+type ReverserRegistry interface {
+	Register(reverser Reverser)
+	Acquire(dialect string) (Reverser, bool)
+	Contains(dialect string) bool
+}
+
+// Args the connection arguments of database mode.
+type Args struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	Database string
+	// Params the connection parameters of the DSN, over the defaults of the dialect, e.g. sslmode.
+	Params map[string]string
+}
+
+// ----------------------------------------------------------------
+
+type reverserRegistry struct {
+	reversers map[string]Reverser
+}
+
+func NewReverserRegistry() ReverserRegistry {
+	return &reverserRegistry{
+		reversers: make(map[string]Reverser),
+	}
+}
+
+// ----------------------------------------------------------------
+
+func (r *reverserRegistry) Register(reverser Reverser) {
+	r.reversers[reverser.Dialect()] = reverser
+}
+
+func (r *reverserRegistry) Acquire(dialect string) (Reverser, bool) {
+	reverser, ok := r.reversers[dialect]
+
+	return reverser, ok
+}
+
+func (r *reverserRegistry) Contains(dialect string) bool {
+	_, ok := r.reversers[dialect]
+
+	return ok
+}
+
+// ----------------------------------------------------------------
+
+func RegisterReverser(reverser Reverser) {
+	_reverserRegistry.Register(reverser)
+}
+
+func AcquireReverser(dialect string) (Reverser, bool) {
+	return _reverserRegistry.Acquire(dialect)
+}
+
+func ContainsReverser(dialect string) bool {
+	return _reverserRegistry.Contains(dialect)
+}
+
+// ----------------------------------------------------------------
+
+// Reverse fetches the metadata of every table accepted by filter with a pool of
+// concurrency workers.
+//
+// The tables of the returned ast.Database keep the order reported by Reverser.Tables,
+// whatever order the workers finish in. The first failure, or the cancellation of ctx,
+// stops the remaining workers and is returned.
+func Reverse(
+	ctx context.Context,
+	reverser Reverser,
+	db *sql.DB,
+	database string,
+	concurrency int,
+	filter func(table string) bool,
+) (*ast.Database, error) {
+	names, err := reverser.Tables(ctx, db, database)
+	if err != nil {
+		return nil, fmt.Errorf("list tables of database %s failed: %w", database, err)
+	}
+
+	var targets []string
+	for _, name := range names {
+		if filter == nil || filter(name) {
+			targets = append(targets, name)
+		}
+	}
+
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	if concurrency > len(targets) {
+		concurrency = len(targets)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tables := make([]*ast.Table, len(targets))
+	jobs := make(chan int)
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				table, err := reverser.Table(ctx, db, database, targets[idx])
+				if err != nil {
+					fail(fmt.Errorf("reverse table %s failed: %w", targets[idx], err))
+
+					continue
+				}
+
+				tables[idx] = table
+			}
+		}()
+	}
+
+DISPATCH:
+	for idx := range targets {
+		select {
+		case <-ctx.Done():
+			break DISPATCH
+		case jobs <- idx:
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return &ast.Database{
+		Name:   database,
+		Tables: tables,
+	}, nil
+}
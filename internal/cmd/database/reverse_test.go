@@ -0,0 +1,162 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+)
+
+type fakeReverser struct {
+	tables  []string
+	failOn  string
+	running int32
+	peak    int32
+}
+
+func (r *fakeReverser) Dialect() string    { return "fake" }
+func (r *fakeReverser) Driver() string     { return "fake" }
+func (r *fakeReverser) DSN(_ *Args) string { return "" }
+
+func (r *fakeReverser) Tables(_ context.Context, _ *sql.DB, _ string) ([]string, error) {
+	return r.tables, nil
+}
+
+func (r *fakeReverser) Table(ctx context.Context, _ *sql.DB, _, table string) (*ast.Table, error) {
+	running := atomic.AddInt32(&r.running, 1)
+	defer atomic.AddInt32(&r.running, -1)
+
+	for {
+		peak := atomic.LoadInt32(&r.peak)
+		if running <= peak || atomic.CompareAndSwapInt32(&r.peak, peak, running) {
+			break
+		}
+	}
+
+	if table == r.failOn {
+		return nil, errors.New("boom")
+	}
+
+	// Later tables finish first
+	delay := 0
+	for i, it := range r.tables {
+		if it == table {
+			delay = len(r.tables) - i
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(time.Duration(delay) * time.Millisecond):
+	}
+
+	return &ast.Table{Name: table}, nil
+}
+
+func TestReverse(t *testing.T) {
+	var names []string
+	for i := 0; i < 32; i++ {
+		names = append(names, fmt.Sprintf("table_%02d", i))
+	}
+
+	type args struct {
+		reverser    *fakeReverser
+		concurrency int
+		filter      func(string) bool
+	}
+	tests := []struct {
+		name     string
+		args     args
+		want     []string
+		wantErr  bool
+		wantPeak int32
+	}{
+		{
+			name: "test Reverse keeps the table order",
+			args: args{
+				reverser:    &fakeReverser{tables: names},
+				concurrency: 4,
+			},
+			want:     names,
+			wantPeak: 4,
+		},
+		{
+			name: "test Reverse filter",
+			args: args{
+				reverser:    &fakeReverser{tables: names},
+				concurrency: 4,
+				filter: func(table string) bool {
+					return table == "table_03" || table == "table_01"
+				},
+			},
+			want:     []string{"table_01", "table_03"},
+			wantPeak: 2,
+		},
+		{
+			name: "test Reverse failure",
+			args: args{
+				reverser:    &fakeReverser{tables: names, failOn: "table_07"},
+				concurrency: 4,
+			},
+			wantErr:  true,
+			wantPeak: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Reverse(context.Background(), tt.args.reverser, nil, "hello", tt.args.concurrency, tt.args.filter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Reverse() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if peak := atomic.LoadInt32(&tt.args.reverser.peak); peak > tt.wantPeak {
+				t.Errorf("Reverse() peak concurrency = %d, want <= %d", peak, tt.wantPeak)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(got.Tables) != len(tt.want) {
+				t.Fatalf("Reverse() got %d tables, want %d", len(got.Tables), len(tt.want))
+			}
+			for i, table := range got.Tables {
+				if table.Name != tt.want[i] {
+					t.Errorf("Reverse() got.Tables[%d] = %s, want %s", i, table.Name, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReverse_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	reverser := &fakeReverser{tables: []string{"a", "b", "c"}}
+	if _, err := Reverse(ctx, reverser, nil, "hello", 2, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Reverse() error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+	"github.com/photowey/liquigen/internal/version"
+	"github.com/photowey/liquigen/pkg/jsonz"
+	"gopkg.in/yaml.v3"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	// Version the current version of the snapshot file layout.
+	//
+	// Bump it whenever a change of ast.Database breaks the files written before.
+	Version = 1
+
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// ----------------------------------------------------------------
+
+// Snapshot a serialised ast.Database, written by `liquigen snapshot` and read back
+// by `liquigen changelog --snapshot`.
+type Snapshot struct {
+	Version   int           `json:"version" yaml:"version"`
+	Generator string        `json:"generator" yaml:"generator"`
+	Dialect   string        `json:"dialect" yaml:"dialect"`
+	Database  *ast.Database `json:"database" yaml:"database"`
+}
+
+func NewSnapshot(dialect string, database *ast.Database) *Snapshot {
+	return &Snapshot{
+		Version:   Version,
+		Generator: "liquigen " + version.Now(),
+		Dialect:   dialect,
+		Database:  database,
+	}
+}
+
+// ----------------------------------------------------------------
+
+// Format detects the format of a snapshot file by its extension, json by default.
+func Format(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return FormatYAML
+	default:
+		return FormatJSON
+	}
+}
+
+func Marshal(snapshot *Snapshot, format string) ([]byte, error) {
+	switch format {
+	case FormatYAML:
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(snapshot); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), encoder.Close()
+	case FormatJSON:
+		data, err := jsonz.PrettyE(snapshot)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(data + "\n"), nil
+	default:
+		return nil, fmt.Errorf("snapshot: unsupported format %s", format)
+	}
+}
+
+func Unmarshal(data []byte, format string) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+
+	var err error
+	switch format {
+	case FormatYAML:
+		err = yaml.Unmarshal(data, snapshot)
+	case FormatJSON:
+		err = jsonz.UnmarshalStructE(data, snapshot)
+	default:
+		err = fmt.Errorf("snapshot: unsupported format %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validate(snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// ----------------------------------------------------------------
+
+func Write(path string, snapshot *Snapshot) error {
+	data, err := Marshal(snapshot, Format(path))
+	if err != nil {
+		return err
+	}
+
+	if dir := filepath.Dir(path); dir != "" {
+		if err = os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+func Read(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := Unmarshal(data, Format(path))
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot %s failed: %w", path, err)
+	}
+
+	return snapshot, nil
+}
+
+// ----------------------------------------------------------------
+
+func validate(snapshot *Snapshot) error {
+	if snapshot.Version <= 0 {
+		return errors.New("snapshot: missing version")
+	}
+	if snapshot.Version > Version {
+		return fmt.Errorf("snapshot: version %d is newer than the supported version %d, please upgrade liquigen",
+			snapshot.Version, Version)
+	}
+	if snapshot.Database == nil {
+		return errors.New("snapshot: missing database")
+	}
+
+	for _, table := range snapshot.Database.Tables {
+		if table == nil || table.Name == "" {
+			return errors.New("snapshot: table without name")
+		}
+		for _, column := range table.Columns {
+			if column == nil || column.Name == "" || column.DataType == "" {
+				return fmt.Errorf("snapshot: table %s has a column without name or data type", table.Name)
+			}
+		}
+	}
+
+	return nil
+}
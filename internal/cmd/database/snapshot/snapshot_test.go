@@ -0,0 +1,117 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package snapshot
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/photowey/liquigen/internal/cmd/database/ast"
+)
+
+func TestWriteRead(t *testing.T) {
+	length := 32
+	precision, scale := 16, 2
+
+	database := &ast.Database{
+		Name: "company",
+		Tables: []*ast.Table{
+			{
+				Database: "company",
+				Name:     "employee",
+				Comment:  "EMPLOYEE",
+				Columns: []*ast.Column{
+					{Name: "id", DataType: "bigint", NotNull: true, PrimaryKey: true, AutoIncrement: true, Comment: "ID"},
+					{Name: "employee_no", DataType: "varchar", Length: &length, NotNull: true, Comment: "No"},
+					{Name: "balance", DataType: "decimal", Precision: &precision, Scale: &scale, Default: "0"},
+				},
+				Indexes: []*ast.Index{
+					{Name: "uk_employee_no", Unique: true, Columns: []string{"employee_no"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		file string
+	}{
+		{name: "test json snapshot", file: "snapshot.json"},
+		{name: "test yaml snapshot", file: "snapshot.yaml"},
+		{name: "test yml snapshot", file: "snapshot.yml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := Write(path, NewSnapshot("mysql", database)); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+
+			got, err := Read(path)
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			if got.Version != Version || got.Dialect != "mysql" {
+				t.Errorf("Read() got version = %d, dialect = %s", got.Version, got.Dialect)
+			}
+			if !reflect.DeepEqual(got.Database, database) {
+				t.Errorf("Read() got database = %+v, want %+v", got.Database, database)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		format  string
+		wantErr bool
+	}{
+		{
+			name:   "test Unmarshal current version",
+			data:   `{"version": 1, "dialect": "mysql", "database": {"name": "company", "tables": []}}`,
+			format: FormatJSON,
+		},
+		{
+			name:    "test Unmarshal missing version",
+			data:    `{"dialect": "mysql", "database": {"name": "company", "tables": []}}`,
+			format:  FormatJSON,
+			wantErr: true,
+		},
+		{
+			name:    "test Unmarshal newer version",
+			data:    "version: 99\ndatabase:\n  name: company\n",
+			format:  FormatYAML,
+			wantErr: true,
+		},
+		{
+			name:    "test Unmarshal column without data type",
+			data:    "version: 1\ndatabase:\n  name: company\n  tables:\n    - name: employee\n      columns:\n        - name: id\n",
+			format:  FormatYAML,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Unmarshal([]byte(tt.data), tt.format); (err != nil) != tt.wantErr {
+				t.Errorf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
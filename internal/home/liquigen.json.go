@@ -25,7 +25,21 @@ const (
     "email": "hello@example.com",
     "version": "1.0.0",
     "dialect": "mysql",
-    "sql": ""
+    "sql": "",
+    "build": "maven",
+    "groupId": "io.github.photowey",
+    "artifactId": "liquibase-changelog",
+    "artifactVersion": "1.0.0-SNAPSHOT",
+    "package": "io.github.photowey.liquibase.changelog",
+    "serverPort": 9527,
+    "javaVersion": "1.8",
+    "springBootVersion": "2.7.5",
+    "liquibaseVersion": "4.9.1",
+    "drivers": {
+      "mysql": "mysql:mysql-connector-java",
+      "postgres": "org.postgresql:postgresql",
+      "sqlite": "org.xerial:sqlite-jdbc"
+    }
   },
   "database": {
     "host": "127.0.0.1",
@@ -35,9 +49,48 @@ const (
     "database": "hello_world",
     "username": "root",
     "password": "root",
+    "concurrency": 8,
     "includes": [],
     "excludes": [],
-    "prefixes": []
+    "prefixes": [],
+    "params": {}
+  },
+  "output": {
+    "dir": "",
+    "project": "liquibase-changelog",
+    "path": "",
+    "file": "{{table}}_{{version}}.xml",
+    "master": "",
+    "scaffold": "full",
+    "baseline": "liquibase-baseline/{{dialect}}"
+  },
+  "changeSet": {
+    "id": "{{table}}_{{date}}_{{seq}}",
+    "sequence": 3,
+    "author": "{{author}}",
+    "context": "dev,test,stage,prod",
+    "labels": "v{{version}}",
+    "tables": {},
+    "preconditions": false,
+    "onFail": "MARK_RAN",
+    "quoting": "reserved"
+  },
+  "lint": {
+    "dialects": [],
+    "rules": {
+      "table-comment": "error",
+      "column-comment": "warning",
+      "primary-key": "error",
+      "snake-case": "error",
+      "reserved-word": "error",
+      "identifier-length": "error",
+      "varchar-length": "warning",
+      "audit-columns": "warning"
+    },
+    "identifierLength": 0,
+    "varcharLength": 4000,
+    "auditColumns": ["create_by", "create_time", "update_by", "update_time"],
+    "reservedWords": []
   }
 }`
 )
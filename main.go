@@ -20,6 +20,8 @@ import (
 	App "github.com/photowey/liquigen/cmd/cmder/app"
 	_ "github.com/photowey/liquigen/internal/cmd/database/ast/parser/mysql"
 	_ "github.com/photowey/liquigen/internal/cmd/database/ast/parser/postgres"
+	_ "github.com/photowey/liquigen/internal/cmd/database/dialect/mysqld"
+	_ "github.com/photowey/liquigen/internal/cmd/database/dialect/postgresd"
 )
 
 func main() {
@@ -0,0 +1,255 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diffz
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ----------------------------------------------------------------
+
+const (
+	DefaultContext = 3
+)
+
+// maxEdits the most edits searched between two files, those differing more are rendered as
+// replaced at once.
+const maxEdits = 1000
+
+// ----------------------------------------------------------------
+
+type op struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// Unified renders the unified diff turning from into to, with context lines around the
+// changes, an empty string when they are equal.
+func Unified(fromName, toName, from, to string, context int) string {
+	if from == to {
+		return ""
+	}
+
+	ops := diffLines(splitLines(from), splitLines(to))
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "--- %s\n+++ %s\n", fromName, toName)
+
+	for start := 0; start < len(ops); {
+		// The next change.
+		first := start
+		for first < len(ops) && ops[first].kind == ' ' {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+
+		// Extend the hunk while the changes are separated by at most 2*context equal lines.
+		last := first
+		for i := first; i < len(ops); i++ {
+			if ops[i].kind != ' ' {
+				last = i
+			} else if i-last > 2*context {
+				break
+			}
+		}
+
+		begin := max(first-context, start)
+		end := min(last+context+1, len(ops))
+
+		writeHunk(&buf, ops, begin, end)
+
+		start = end
+	}
+
+	return buf.String()
+}
+
+func writeHunk(buf *strings.Builder, ops []op, begin, end int) {
+	fromLine, toLine := 1, 1
+	for _, it := range ops[:begin] {
+		if it.kind != '+' {
+			fromLine++
+		}
+		if it.kind != '-' {
+			toLine++
+		}
+	}
+
+	fromCount, toCount := 0, 0
+	for _, it := range ops[begin:end] {
+		if it.kind != '+' {
+			fromCount++
+		}
+		if it.kind != '-' {
+			toCount++
+		}
+	}
+
+	fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(fromLine, fromCount), hunkRange(toLine, toCount))
+	for _, it := range ops[begin:end] {
+		buf.WriteByte(it.kind)
+		buf.WriteString(it.line)
+		buf.WriteByte('\n')
+	}
+}
+
+func hunkRange(line, count int) string {
+	if count == 0 {
+		line--
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", line)
+	}
+
+	return fmt.Sprintf("%d,%d", line, count)
+}
+
+// diffLines the shortest edit turning from into to, searched by myers between their common
+// prefix and suffix; the lines between them are replaced at once past maxEdits edits.
+func diffLines(from, to []string) []op {
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix && from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+
+	var ops []op
+	for _, line := range from[:prefix] {
+		ops = append(ops, op{' ', line})
+	}
+
+	fromMiddle, toMiddle := from[prefix:len(from)-suffix], to[prefix:len(to)-suffix]
+	if middle, ok := myers(fromMiddle, toMiddle); ok {
+		ops = append(ops, middle...)
+	} else {
+		for _, line := range fromMiddle {
+			ops = append(ops, op{'-', line})
+		}
+		for _, line := range toMiddle {
+			ops = append(ops, op{'+', line})
+		}
+	}
+
+	for _, line := range from[len(from)-suffix:] {
+		ops = append(ops, op{' ', line})
+	}
+
+	return ops
+}
+
+// myers the shortest edit turning from into to, by the O((N+M)D) algorithm of Myers; false
+// past maxEdits edits, bounding the trace to O(maxEdits^2).
+func myers(from, to []string) ([]op, bool) {
+	n, m := len(from), len(to)
+
+	// trace[d] the furthest x reached on the diagonals k = x-y = -d..d after d edits, at k+d.
+	var trace [][]int
+	for d := 0; d <= min(n+m, maxEdits); d++ {
+		v := make([]int, 2*d+1)
+		for k := -d; k <= d; k += 2 {
+			x := 0
+			if d > 0 {
+				x = furthest(trace[d-1], d, k)
+			}
+			y := x - k
+			for x < n && y < m && from[x] == to[y] {
+				x++
+				y++
+			}
+
+			v[k+d] = x
+			if x >= n && y >= m {
+				return backtrack(from, to, append(trace, v)), true
+			}
+		}
+
+		trace = append(trace, v)
+	}
+
+	return nil, false
+}
+
+// furthest the x diagonal k starts from after d edits, before its snake: down from k+1 by an
+// insertion, or right from k-1 by a deletion; prev the trace of d-1 edits.
+func furthest(prev []int, d, k int) int {
+	if down(prev, d, k) {
+		return prev[k+1+d-1]
+	}
+
+	return prev[k-1+d-1] + 1
+}
+
+func down(prev []int, d, k int) bool {
+	return k == -d || (k != d && prev[k-1+d-1] < prev[k+1+d-1])
+}
+
+// backtrack the edit ending the trace, walked back from the end of from and to.
+func backtrack(from, to []string, trace [][]int) []op {
+	x, y := len(from), len(to)
+
+	var reversed []op
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := trace[d-1]
+		k := x - y
+
+		prevK := k - 1
+		if down(prev, d, k) {
+			prevK = k + 1
+		}
+		prevX := prev[prevK+d-1]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			reversed = append(reversed, op{' ', from[x]})
+		}
+		if x == prevX {
+			y--
+			reversed = append(reversed, op{'+', to[y]})
+		} else {
+			x--
+			reversed = append(reversed, op{'-', from[x]})
+		}
+	}
+	for x > 0 && y > 0 {
+		x--
+		y--
+		reversed = append(reversed, op{' ', from[x]})
+	}
+
+	ops := make([]op, 0, len(reversed))
+	for i := len(reversed) - 1; i >= 0; i-- {
+		ops = append(ops, reversed[i])
+	}
+
+	return ops
+}
+
+func splitLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright © 2024 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diffz
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want string
+	}{
+		{
+			name: "test equal",
+			from: "a\nb\n",
+			to:   "a\nb\n",
+			want: "",
+		},
+		{
+			name: "test change",
+			from: "a\nb\nc\nd\ne\nf\ng\nh\n",
+			to:   "a\nb\nc\nd\nE\nf\ng\nh\n",
+			want: "--- a.xml\n+++ b.xml\n@@ -2,7 +2,7 @@\n b\n c\n d\n-e\n+E\n f\n g\n h\n",
+		},
+		{
+			name: "test create",
+			from: "",
+			to:   "a\nb\n",
+			want: "--- a.xml\n+++ b.xml\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			name: "test two hunks",
+			from: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+			to:   "0\n2\n3\n4\n5\n6\n7\n8\n9\n11\n",
+			want: "--- a.xml\n+++ b.xml\n@@ -1,4 +1,4 @@\n-1\n+0\n 2\n 3\n 4\n@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-10\n+11\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unified("a.xml", "b.xml", tt.from, tt.to, DefaultContext); got != tt.want {
+				t.Errorf("Unified() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	lines := func(prefix string, n int) []string {
+		var values []string
+		for i := 0; i < n; i++ {
+			values = append(values, prefix+strconv.Itoa(i))
+		}
+
+		return values
+	}
+
+	tests := []struct {
+		name      string
+		from      []string
+		to        []string
+		wantEdits int
+	}{
+		{name: "test interleaved", from: strings.Split("a b c a b b a", " "), to: strings.Split("c b a b a c", " "), wantEdits: 5},
+		{name: "test moved", from: strings.Split("a b c d e", " "), to: strings.Split("b c d e a", " "), wantEdits: 2},
+		{name: "test common prefix and suffix", from: strings.Split("a b x y c d", " "), to: strings.Split("a b z c d", " "), wantEdits: 3},
+		{name: "test large", from: lines("a", 20000), to: append(lines("a", 10000), lines("a", 20000)[10001:]...), wantEdits: 1},
+		{name: "test replaced", from: lines("a", 5000), to: lines("b", 5000), wantEdits: 10000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var from, to []string
+			edits := 0
+			for _, it := range diffLines(tt.from, tt.to) {
+				if it.kind != '+' {
+					from = append(from, it.line)
+				}
+				if it.kind != '-' {
+					to = append(to, it.line)
+				}
+				if it.kind != ' ' {
+					edits++
+				}
+			}
+
+			if !reflect.DeepEqual(from, tt.from) || !reflect.DeepEqual(to, tt.to) {
+				t.Errorf("diffLines() doesn't turn from into to")
+			}
+			if edits != tt.wantEdits {
+				t.Errorf("diffLines() got %d edits, want %d", edits, tt.wantEdits)
+			}
+		})
+	}
+}